@@ -8,12 +8,18 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gtk"
 	"github.com/mattn/go-shellwords"
+	"github.com/pdf/hyprpanel/internal/eventbus"
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
 	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
 	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
 	"github.com/pdf/hyprpanel/style"
 )
 
+var audioEventKinds = []eventv1.EventKind{
+	eventv1.EventKind_EVENT_KIND_AUDIO_SINK_CHANGE,
+	eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_CHANGE,
+}
+
 const (
 	audioSinkIconScale   = 0.9
 	audioSourceIconScale = 0.4
@@ -252,6 +258,15 @@ func (a *audio) events() chan<- *eventv1.Event {
 	return a.eventCh
 }
 
+func (a *audio) kinds() []eventv1.EventKind {
+	return audioEventKinds
+}
+
+// policy does not coalesce, events for each sink and source share a kind.
+func (a *audio) policy() eventbus.Policy {
+	return eventbus.PolicyDropOldest
+}
+
 func (a *audio) watch() {
 	for {
 		select {
@@ -9,12 +9,17 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gtk"
 	"github.com/jwijenbergh/puregotk/v4/pango"
+	"github.com/pdf/hyprpanel/internal/eventbus"
 	gtk4layershell "github.com/pdf/hyprpanel/internal/gtk4-layer-shell"
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
 	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
 	"github.com/pdf/hyprpanel/style"
 )
 
+var hudEventKinds = []eventv1.EventKind{
+	eventv1.EventKind_EVENT_KIND_HUD_NOTIFY,
+}
+
 type hud struct {
 	*refTracker
 	*api
@@ -341,6 +346,14 @@ func (h *hud) events() chan<- *eventv1.Event {
 	return h.eventCh
 }
 
+func (h *hud) kinds() []eventv1.EventKind {
+	return hudEventKinds
+}
+
+func (h *hud) policy() eventbus.Policy {
+	return eventbus.PolicyCoalesce
+}
+
 func (h *hud) watch() {
 	for {
 		select {
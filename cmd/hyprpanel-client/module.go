@@ -1,10 +1,19 @@
 package main
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/jwijenbergh/puregotk/v4/gtk"
+	"github.com/pdf/hyprpanel/internal/eventbus"
+	"github.com/pdf/hyprpanel/internal/hypripc"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
 )
 
+const moduleQueueSize = 64
+
 type module interface {
 	build(container *gtk.Box) error
 	close(container *gtk.Box)
@@ -12,4 +21,61 @@ type module interface {
 
 type moduleReceiver interface {
 	events() chan<- *eventv1.Event
+	kinds() []eventv1.EventKind
+	// policy returns the overflow policy for the receiver queue. Events are
+	// coalesced by kind, so coalescing is only safe when each event replaces
+	// all previous events of the same kind.
+	policy() eventbus.Policy
+}
+
+// moduleEventKinds returns the event kinds consumed by the modules configured
+// for the panel, and whether any of the modules require the Hyprland state.
+func moduleEventKinds(cfg *configv1.Panel) ([]eventv1.EventKind, bool) {
+	var (
+		kinds     []eventv1.EventKind
+		needState bool
+	)
+	for _, modCfg := range cfg.Modules {
+		switch modCfg.Kind.(type) {
+		case *modulev1.Module_Pager, *modulev1.Module_Taskbar:
+			needState = true
+		case *modulev1.Module_Notifications:
+			needState = true
+			kinds = append(kinds, notificationsEventKinds...)
+		case *modulev1.Module_Systray:
+			kinds = append(kinds, systrayEventKinds...)
+		case *modulev1.Module_Hud:
+			kinds = append(kinds, hudEventKinds...)
+		case *modulev1.Module_Audio:
+			kinds = append(kinds, audioEventKinds...)
+		case *modulev1.Module_Power:
+			kinds = append(kinds, powerEventKinds...)
+		}
+	}
+	if needState {
+		kinds = append(kinds, hypripc.StateEventKinds...)
+	}
+	slices.Sort(kinds)
+
+	return slices.Compact(kinds), needState
+}
+
+// subscribe forwards events from the bus to the receiver until quitCh is
+// closed, each receiver has its own queue so that a slow module does not
+// delay others.
+func subscribe(refs *refTracker, bus *eventbus.Bus, rec moduleReceiver, quitCh <-chan struct{}) {
+	name := fmt.Sprintf("%T", rec)
+	sub := bus.Subscribe(
+		eventbus.WithName(name),
+		eventbus.WithQueueSize(moduleQueueSize),
+		eventbus.WithPolicy(rec.policy()),
+		eventbus.WithKinds(rec.kinds()...),
+	)
+	go sub.Forward(rec.events(), quitCh)
+	refs.AddRef(func() {
+		if dropped := sub.Dropped(); dropped > 0 {
+			log.Debug(`Module dropped events`, `module`, name, `dropped`, dropped)
+		}
+		sub.Close()
+	})
 }
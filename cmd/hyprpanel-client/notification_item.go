@@ -278,12 +278,7 @@ func (i *notificationItem) build(container *gtk.Box) error {
 						log.Debug(`Malformed pid`, `module`, style.NotificationsID, hint.Key, hint.Value, `err`, err)
 						return
 					}
-					clients, err := i.hypr.Clients()
-					if err != nil {
-						break
-					}
-
-					for _, client := range clients {
+					for _, client := range i.state.Clients() {
 						if client.Pid == pid {
 							if err := i.focusWindow(client.Address); err != nil {
 								log.Debug(`Failed to focus window`, `module`, style.NotificationsID, `address`, client.Address, `err`, err)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gtk"
+	"github.com/pdf/hyprpanel/internal/eventbus"
 	gtk4layershell "github.com/pdf/hyprpanel/internal/gtk4-layer-shell"
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
 	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
@@ -13,6 +14,11 @@ import (
 	"github.com/pdf/hyprpanel/style"
 )
 
+var notificationsEventKinds = []eventv1.EventKind{
+	eventv1.EventKind_EVENT_KIND_DBUS_NOTIFICATION,
+	eventv1.EventKind_EVENT_KIND_DBUS_CLOSENOTIFICATION,
+}
+
 type notifications struct {
 	*refTracker
 	*api
@@ -151,6 +157,15 @@ func (n *notifications) events() chan<- *eventv1.Event {
 	return n.eventCh
 }
 
+func (n *notifications) kinds() []eventv1.EventKind {
+	return notificationsEventKinds
+}
+
+// policy does not coalesce, every event carries a distinct item.
+func (n *notifications) policy() eventbus.Policy {
+	return eventbus.PolicyDropOldest
+}
+
 func (n *notifications) watch() {
 	for {
 		select {
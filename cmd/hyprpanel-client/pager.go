@@ -4,12 +4,10 @@ import (
 	"errors"
 	"sort"
 	"strconv"
-	"time"
 
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gtk"
 	"github.com/pdf/hyprpanel/internal/hypripc"
-	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
 	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
 	"github.com/pdf/hyprpanel/style"
 )
@@ -66,7 +64,6 @@ type pager struct {
 	workspaces       map[int]*pagerWorkspace
 	clientWorkspaces map[string]int
 	sortedWorkspaces sortedWorkspaces
-	eventCh          chan *eventv1.Event
 	quitCh           chan struct{}
 
 	container *gtk.Box
@@ -140,15 +137,21 @@ func (p *pager) deleteClient(addr string) {
 	delete(p.clientWorkspaces, addr)
 }
 
+// update reconciles the pager with the hyprland state, discarding clients that no longer exist.
 func (p *pager) update() error {
-	spaces, err := p.hypr.Workspaces()
-	if err != nil {
-		return err
+	p.activeClient = p.state.ActiveWindow().Address
+	p.activeWorkspace = p.state.ActiveWorkspace().ID
+	spaces := p.state.Workspaces()
+	clients := p.state.Clients()
+
+	liveClients := make(map[string]struct{}, len(clients))
+	for _, client := range clients {
+		liveClients[client.Address] = struct{}{}
 	}
-
-	clients, err := p.hypr.Clients()
-	if err != nil {
-		return err
+	for addr := range p.clientWorkspaces {
+		if _, ok := liveClients[addr]; !ok {
+			p.deleteClient(addr)
+		}
 	}
 
 	live := make(map[int]struct{})
@@ -186,17 +189,8 @@ func (p *pager) update() error {
 }
 
 func (p *pager) build(container *gtk.Box) error {
-	activeClient, err := p.hypr.ActiveWindow()
-	if err != nil {
-		return err
-	}
-	p.activeClient = activeClient.Address
-
-	activeWorkspace, err := p.hypr.ActiveWorkspace()
-	if err != nil {
-		return err
-	}
-	p.activeWorkspace = activeWorkspace.ID
+	p.activeClient = p.state.ActiveWindow().Address
+	p.activeWorkspace = p.state.ActiveWorkspace().ID
 
 	p.container = gtk.NewBox(p.orientation, 0)
 	p.AddRef(p.container.Unref)
@@ -299,10 +293,6 @@ func (p *pager) build(container *gtk.Box) error {
 	return nil
 }
 
-func (p *pager) events() chan<- *eventv1.Event {
-	return p.eventCh
-}
-
 func (p *pager) close(container *gtk.Box) {
 	defer p.Unref()
 	for _, ws := range p.workspaces {
@@ -314,8 +304,8 @@ func (p *pager) close(container *gtk.Box) {
 }
 
 func (p *pager) watch() {
-	ticker := time.NewTicker(time.Second)
-	p.AddRef(ticker.Stop)
+	changeCh, cancel := p.state.Watch()
+	p.AddRef(cancel)
 
 	for {
 		select {
@@ -325,67 +315,8 @@ func (p *pager) watch() {
 			select {
 			case <-p.quitCh:
 				return
-			case <-ticker.C:
-			case evt := <-p.eventCh:
-				switch evt.Kind {
-				case eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACEV2:
-					data := &eventv1.HyprWorkspaceV2Value{}
-					if !evt.Data.MessageIs(data) {
-						log.Warn(`Invalid event`, `module`, style.PagerID, `evt`, evt)
-						continue
-					}
-					if err := evt.Data.UnmarshalTo(data); err != nil {
-						log.Warn(`Invalid event`, `module`, style.PagerID, `evt`, evt)
-						continue
-					}
-					p.activeWorkspace = int(data.Id)
-				case eventv1.EventKind_EVENT_KIND_HYPR_CREATEWORKSPACEV2:
-				case eventv1.EventKind_EVENT_KIND_HYPR_CLOSEWINDOW:
-					addr, err := eventv1.DataString(evt.Data)
-					if err != nil {
-						log.Warn(`Invalid event`, `module`, style.PagerID, `evt`, evt)
-						continue
-					}
-					var cb glib.SourceFunc
-					cb = func(uintptr) bool {
-						defer unrefCallback(&cb)
-						p.deleteClient(addr)
-						return false
-					}
-					glib.IdleAdd(&cb, 0)
-					continue
-				case eventv1.EventKind_EVENT_KIND_HYPR_ACTIVEWINDOWV2:
-					addr, err := eventv1.DataString(evt.Data)
-					if err != nil {
-						log.Warn(`Invalid event`, `module`, style.PagerID, `evt`, evt)
-						continue
-					}
-					p.activeClient = addr
-				case eventv1.EventKind_EVENT_KIND_HYPR_DESTROYWORKSPACEV2:
-				case eventv1.EventKind_EVENT_KIND_HYPR_MOVEWORKSPACEV2:
-				case eventv1.EventKind_EVENT_KIND_HYPR_RENAMEWORKSPACE:
-					data := &eventv1.HyprRenameWorkspaceValue{}
-					if !evt.Data.MessageIs(data) {
-						log.Warn(`Invalid event`, `module`, style.PagerID, `evt`, evt)
-						continue
-					}
-					if err := evt.Data.UnmarshalTo(data); err != nil {
-						log.Warn(`Invalid event`, `module`, style.PagerID, `evt`, evt)
-						continue
-					}
-					var cb glib.SourceFunc
-					cb = func(uintptr) bool {
-						defer unrefCallback(&cb)
-						p.renameWorkspace(int(data.Id), data.Name)
-						return false
-					}
-					glib.IdleAdd(&cb, 0)
-					continue
-				case eventv1.EventKind_EVENT_KIND_HYPR_OPENWINDOW:
-				case eventv1.EventKind_EVENT_KIND_HYPR_FULLSCREEN:
-				case eventv1.EventKind_EVENT_KIND_HYPR_WINDOWTITLE:
-				case eventv1.EventKind_EVENT_KIND_HYPR_MOVEWINDOWV2:
-				default:
+			case change := <-changeCh:
+				if !change.Has(hypripc.ChangeWorkspaces | hypripc.ChangeClients | hypripc.ChangeActive) {
 					continue
 				}
 			}
@@ -395,9 +326,7 @@ func (p *pager) watch() {
 				defer unrefCallback(&cb)
 				if err := p.update(); err != nil {
 					log.Debug(`Failed updating`, `module`, style.PagerID, `err`, err)
-					return false
 				}
-
 				return false
 			}
 
@@ -416,12 +345,10 @@ func newPager(cfg *modulev1.Pager, a *api) *pager {
 		cfg:              cfg,
 		workspaces:       make(map[int]*pagerWorkspace),
 		clientWorkspaces: make(map[string]int),
-		eventCh:          make(chan *eventv1.Event),
 		quitCh:           make(chan struct{}),
 	}
 	p.AddRef(func() {
 		close(p.quitCh)
-		close(p.eventCh)
 	})
 
 	if p.orientation == gtk.OrientationHorizontalValue {
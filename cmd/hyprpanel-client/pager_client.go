@@ -105,7 +105,7 @@ func (c *pagerClient) clientAddress() string {
 }
 
 func (c *pagerClient) shouldPreview() bool {
-	return c.client != nil
+	return c.client != nil && c.caps.Has(hypripc.CapabilityToplevelExport)
 }
 
 func (c *pagerClient) build(container *gtk.Fixed) {
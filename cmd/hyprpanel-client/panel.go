@@ -9,7 +9,9 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gtk"
+	"github.com/pdf/hyprpanel/internal/eventbus"
 	gtk4layershell "github.com/pdf/hyprpanel/internal/gtk4-layer-shell"
 	"github.com/pdf/hyprpanel/internal/hypripc"
 	"github.com/pdf/hyprpanel/internal/panelplugin"
@@ -19,13 +21,20 @@ import (
 	"github.com/pdf/hyprpanel/style"
 )
 
-const appName = `com.c0dedbad.hyprpanel.client`
+const (
+	appName = `com.c0dedbad.hyprpanel.client`
+	// stateQueueSize is larger than the module queue size, as events dropped
+	// by the state are not recovered until the next resync.
+	stateQueueSize = 256
+)
 
 var errNotFound = errors.New(`not found`)
 
 type api struct {
 	host           panelplugin.Host
 	hypr           *hypripc.HyprIPC
+	state          *hypripc.State
+	caps           *hypripc.Capabilities
 	orientation    gtk.Orientation
 	currentMonitor *hypripc.Monitor
 	panelCfg       *configv1.Panel
@@ -45,19 +54,34 @@ type panel struct {
 
 	currentGDKMonitor *gdk.Monitor
 
+	userCSSProvider *gtk.CssProvider
+
 	win       *gtk.Window
 	container *gtk.Box
 
-	modules   []module
-	eventCh   chan *eventv1.Event
-	receivers map[module]chan<- *eventv1.Event
-	readyCh   chan struct{}
-	quitCh    chan struct{}
+	modules []module
+	bus     *eventbus.Bus
+	readyCh chan struct{}
+	quitCh  chan struct{}
 }
 
-func (p *panel) Init(host panelplugin.Host, id string, loglevel configv1.LogLevel, cfg *configv1.Panel, stylesheet []byte) error {
+func (p *panel) Init(host panelplugin.Host, id string, loglevel configv1.LogLevel, cfg *configv1.Panel, stylesheet []byte, hyprSnapshot *eventv1.HyprSnapshotValue) ([]eventv1.EventKind, bool, error) {
 	defer close(p.readyCh)
 	log.SetLevel(hclog.Level(loglevel))
+	if hyprSnapshot != nil {
+		snap, err := hypripc.SnapshotFromValue(hyprSnapshot)
+		if err != nil {
+			return nil, false, err
+		}
+		p.hypr = hypripc.NewOffline(log, snap)
+	} else {
+		hypr, err := hypripc.New(log)
+		if err != nil {
+			return nil, false, err
+		}
+		p.hypr = hypr
+	}
+	p.AddRef(p.hypr.Close)
 	p.host = host
 	p.id = id
 	p.panelCfg = cfg
@@ -70,16 +94,53 @@ func (p *panel) Init(host panelplugin.Host, id string, loglevel configv1.LogLeve
 		p.orientation = gtk.OrientationVerticalValue
 	}
 
+	kinds, _ := moduleEventKinds(cfg)
+
+	return kinds, true, nil
+}
+
+func (p *panel) Notify(evt *eventv1.Event) error {
+	log.Trace(`received panel event`, `panelID`, p.id, `evt`, evt.Kind.String())
+	p.bus.Publish(evt)
+
 	return nil
 }
 
-func (p *panel) Notify(evt *eventv1.Event) {
-	select {
-	case <-p.quitCh:
-		return
-	default:
-		p.eventCh <- evt
+func (p *panel) SetVisible(visible bool) error {
+	var cb glib.SourceFunc
+	cb = func(uintptr) bool {
+		defer unrefCallback(&cb)
+		if p.win == nil {
+			return false
+		}
+		p.win.SetVisible(visible)
+		return false
 	}
+	glib.IdleAdd(&cb, 0)
+
+	return nil
+}
+
+func (p *panel) UpdateStyle(stylesheet []byte) error {
+	var cb glib.SourceFunc
+	cb = func(uintptr) bool {
+		defer unrefCallback(&cb)
+		p.stylesheet = stylesheet
+		if p.userCSSProvider == nil {
+			return false
+		}
+		p.userCSSProvider.LoadFromData(string(p.stylesheet), len(p.stylesheet))
+		return false
+	}
+	glib.IdleAdd(&cb, 0)
+
+	return nil
+}
+
+func (p *panel) SetLogLevel(loglevel configv1.LogLevel) error {
+	log.SetLevel(hclog.Level(loglevel))
+
+	return nil
 }
 
 func (p *panel) Context() context.Context {
@@ -100,12 +161,12 @@ func (p *panel) initWindow() error {
 	defaultCSSProvider.LoadFromData(string(style.Default), len(style.Default))
 	gtk.StyleContextAddProviderForDisplay(display, defaultCSSProvider, uint(gtk.STYLE_PROVIDER_PRIORITY_APPLICATION))
 
-	if len(p.stylesheet) > 0 {
-		userCSSProvider := gtk.NewCssProvider()
-		p.AddRef(userCSSProvider.Unref)
-		userCSSProvider.LoadFromData(string(p.stylesheet), len(p.stylesheet))
-		gtk.StyleContextAddProviderForDisplay(display, userCSSProvider, uint(gtk.STYLE_PROVIDER_PRIORITY_USER))
-	}
+	// The user provider is always registered, so that stylesheet updates may be
+	// loaded in place.
+	p.userCSSProvider = gtk.NewCssProvider()
+	p.AddRef(p.userCSSProvider.Unref)
+	p.userCSSProvider.LoadFromData(string(p.stylesheet), len(p.stylesheet))
+	gtk.StyleContextAddProviderForDisplay(display, p.userCSSProvider, uint(gtk.STYLE_PROVIDER_PRIORITY_USER))
 
 	p.win = gtk.NewWindow()
 	p.AddRef(p.win.Unref)
@@ -129,6 +190,9 @@ func (p *panel) initWindow() error {
 	if err != nil {
 		return err
 	}
+	if len(hyprMonitors) == 0 {
+		return errors.New(`no monitors found`)
+	}
 	if p.panelCfg.Monitor != `` {
 		for _, mon := range hyprMonitors {
 			mon := mon
@@ -215,6 +279,31 @@ func (p *panel) build() error {
 	p.AddRef(p.container.Unref)
 	panelMain.Append(&p.container.Widget)
 
+	caps, err := p.hypr.Capabilities()
+	if err != nil {
+		log.Warn(`Failed detecting Hyprland version, assuming all features are available`, `err`, err)
+		caps = hypripc.NewCapabilities(nil)
+	}
+	if p.hypr.Offline() {
+		caps.Set(hypripc.CapabilityToplevelExport, false)
+	}
+	p.caps = caps
+
+	if _, needState := moduleEventKinds(p.panelCfg); needState {
+		stateSub := p.bus.Subscribe(
+			eventbus.WithName(`state`),
+			eventbus.WithQueueSize(stateQueueSize),
+			eventbus.WithKinds(hypripc.StateEventKinds...),
+		)
+		p.AddRef(stateSub.Close)
+		state, err := hypripc.NewState(p.hypr, log, stateSub.Events(), hypripc.DefaultResyncInterval)
+		if err != nil {
+			return err
+		}
+		p.AddRef(state.Close)
+		p.state = state
+	}
+
 	for _, modCfg := range p.panelCfg.Modules {
 		modCfg := modCfg
 		switch modCfg.Kind.(type) {
@@ -266,34 +355,20 @@ func (p *panel) build() error {
 	for _, mod := range p.modules {
 		mod := mod
 		if rec, ok := mod.(moduleReceiver); ok {
-			p.receivers[mod] = rec.events()
+			subscribe(p.refTracker, p.bus, rec, p.quitCh)
 		}
 		if err := mod.build(p.container); err != nil {
 			return err
 		}
 		p.AddRef(func() {
-			delete(p.receivers, mod)
 			mod.close(p.container)
 		})
 	}
-
-	p.AddRef(func() {
-		close(p.eventCh)
-	})
-	go p.watch()
+	p.AddRef(p.bus.Close)
 
 	return nil
 }
 
-func (p *panel) watch() {
-	for evt := range p.eventCh {
-		log.Trace(`received panel event`, `panelID`, p.id, `evt`, evt.Kind.String())
-		for _, rec := range p.receivers {
-			rec <- evt
-		}
-	}
-}
-
 func (p *panel) run() int {
 	<-p.readyCh
 
@@ -302,22 +377,15 @@ func (p *panel) run() int {
 }
 
 func newPanel() (*panel, error) {
-	hypr, err := hypripc.New(log)
-	if err != nil {
-		return nil, err
-	}
-
 	p := &panel{
 		refTracker: newRefTracker(),
 		api: &api{
-			hypr: hypr,
-			app:  gtk.NewApplication(appName, gio.GApplicationFlagsNoneValue),
+			app: gtk.NewApplication(appName, gio.GApplicationFlagsNoneValue),
 		},
-		modules:   make([]module, 0),
-		eventCh:   make(chan *eventv1.Event, 10),
-		receivers: make(map[module]chan<- *eventv1.Event),
-		readyCh:   make(chan struct{}),
-		quitCh:    make(chan struct{}),
+		modules: make([]module, 0),
+		bus:     eventbus.New(),
+		readyCh: make(chan struct{}),
+		quitCh:  make(chan struct{}),
 	}
 	p.AddRef(p.app.Unref)
 	p.AddRef(func() {
@@ -7,11 +7,16 @@ import (
 
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gtk"
+	"github.com/pdf/hyprpanel/internal/eventbus"
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
 	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
 	"github.com/pdf/hyprpanel/style"
 )
 
+var powerEventKinds = []eventv1.EventKind{
+	eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE,
+}
+
 type powerChangeCache map[string]*eventv1.PowerChangeValue
 
 type powerChangeSort []*eventv1.PowerChangeValue
@@ -184,6 +189,15 @@ func (p *power) events() chan<- *eventv1.Event {
 	return p.eventCh
 }
 
+func (p *power) kinds() []eventv1.EventKind {
+	return powerEventKinds
+}
+
+// policy does not coalesce, events for each device share a kind.
+func (p *power) policy() eventbus.Policy {
+	return eventbus.PolicyDropOldest
+}
+
 func (p *power) watch() {
 	for {
 		select {
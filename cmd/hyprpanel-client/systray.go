@@ -7,11 +7,22 @@ import (
 
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gtk"
+	"github.com/pdf/hyprpanel/internal/eventbus"
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
 	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
 	"github.com/pdf/hyprpanel/style"
 )
 
+var systrayEventKinds = []eventv1.EventKind{
+	eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER,
+	eventv1.EventKind_EVENT_KIND_DBUS_UNREGISTERSTATUSNOTIFIER,
+	eventv1.EventKind_EVENT_KIND_DBUS_UPDATETITLE,
+	eventv1.EventKind_EVENT_KIND_DBUS_UPDATETOOLTIP,
+	eventv1.EventKind_EVENT_KIND_DBUS_UPDATEICON,
+	eventv1.EventKind_EVENT_KIND_DBUS_UPDATESTATUS,
+	eventv1.EventKind_EVENT_KIND_DBUS_UPDATEMENU,
+}
+
 const (
 	systrayRevealLabelUp    = `⏶`
 	systrayRevealLabelDown  = `⏷`
@@ -22,12 +33,12 @@ const (
 type systray struct {
 	*refTracker
 	*api
-	cfg       *modulev1.Systray
-	items     map[string]*systrayItem
-	modules   []module
-	receivers map[module]chan<- *eventv1.Event
-	eventCh   chan *eventv1.Event
-	quitCh    chan struct{}
+	cfg     *modulev1.Systray
+	items   map[string]*systrayItem
+	modules []module
+	bus     *eventbus.Bus
+	eventCh chan *eventv1.Event
+	quitCh  chan struct{}
 
 	container             *gtk.Box
 	clientContainer       *gtk.FlowBox
@@ -131,7 +142,7 @@ func (s *systray) build(container *gtk.Box) error {
 
 	for _, mod := range s.modules {
 		if rec, ok := mod.(moduleReceiver); ok {
-			s.receivers[mod] = rec.events()
+			subscribe(s.refTracker, s.bus, rec, s.quitCh)
 		}
 		modContainer := gtk.NewBox(gtk.OrientationHorizontalValue, 0)
 		s.AddRef(modContainer.Unref)
@@ -143,7 +154,6 @@ func (s *systray) build(container *gtk.Box) error {
 			return err
 		}
 		s.AddRef(func() {
-			delete(s.receivers, mod)
 			mod.close(modContainer)
 		})
 		s.clientContainer.Append(&modContainer.Widget)
@@ -162,6 +172,15 @@ func (s *systray) events() chan<- *eventv1.Event {
 	return s.eventCh
 }
 
+func (s *systray) kinds() []eventv1.EventKind {
+	return systrayEventKinds
+}
+
+// policy does not coalesce, every event carries a distinct item.
+func (s *systray) policy() eventbus.Policy {
+	return eventbus.PolicyDropOldest
+}
+
 func (s *systray) watch() {
 	for {
 		select {
@@ -172,9 +191,7 @@ func (s *systray) watch() {
 			case <-s.quitCh:
 				return
 			case evt := <-s.eventCh:
-				for _, rec := range s.receivers {
-					rec <- evt
-				}
+				s.bus.Publish(evt)
 				switch evt.Kind {
 				case eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER:
 					data := &eventv1.StatusNotifierValue{}
@@ -366,7 +383,7 @@ func newSystray(cfg *modulev1.Systray, a *api) *systray {
 		cfg:        cfg,
 		items:      make(map[string]*systrayItem),
 		modules:    make([]module, 0),
-		receivers:  make(map[module]chan<- *eventv1.Event),
+		bus:        eventbus.New(),
 		eventCh:    make(chan *eventv1.Event, 10),
 		quitCh:     make(chan struct{}),
 		inhibitor:  newSystrayInhibitor(cfg, a),
@@ -374,7 +391,7 @@ func newSystray(cfg *modulev1.Systray, a *api) *systray {
 
 	s.AddRef(func() {
 		close(s.quitCh)
-		close(s.eventCh)
+		s.bus.Close()
 	})
 
 	return s
@@ -2,12 +2,10 @@ package main
 
 import (
 	"errors"
-	"time"
 
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gtk"
 	"github.com/pdf/hyprpanel/internal/hypripc"
-	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
 	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
 	"github.com/pdf/hyprpanel/style"
 )
@@ -16,7 +14,6 @@ type taskbar struct {
 	*refTracker
 	*api
 	cfg             *modulev1.Taskbar
-	eventCh         chan *eventv1.Event
 	quitCh          chan struct{}
 	itemSize        uint32
 	itemScale       float64
@@ -99,10 +96,23 @@ func (t *taskbar) deleteClient(addr string) error {
 	return nil
 }
 
+// update reconciles the taskbar with the hyprland state, discarding clients that no longer exist.
 func (t *taskbar) update() error {
-	hyprclients, err := t.hypr.Clients()
-	if err != nil {
-		return err
+	t.activeWorkspace = t.state.ActiveWorkspace().Name
+	t.activeClient = t.state.ActiveWindow().Address
+	hyprclients := t.state.Clients()
+
+	live := make(map[string]struct{}, len(hyprclients))
+	for _, hyprclient := range hyprclients {
+		live[hyprclient.Address] = struct{}{}
+	}
+	for addr := range t.itemClasses {
+		if _, ok := live[addr]; ok {
+			continue
+		}
+		if err := t.deleteClient(addr); err != nil {
+			log.Trace(`Failed deleting stale client`, `module`, style.TaskbarID, `address`, addr, `err`, err)
+		}
 	}
 
 	for _, hyprclient := range hyprclients {
@@ -206,16 +216,8 @@ func (t *taskbar) updateItemScale(itemCount int) {
 }
 
 func (t *taskbar) build(container *gtk.Box) error {
-	activeWorkspace, err := t.hypr.ActiveWorkspace()
-	if err != nil {
-		return err
-	}
-	t.activeWorkspace = activeWorkspace.Name
-	activeWindow, err := t.hypr.ActiveWindow()
-	if err != nil {
-		return err
-	}
-	t.activeClient = activeWindow.Address
+	t.activeWorkspace = t.state.ActiveWorkspace().Name
+	t.activeClient = t.state.ActiveWindow().Address
 
 	// TODO: This is a hack due to currently being unable to create custom widgets
 	// with puregotk. We need to detect when content or neighbour size changes would
@@ -278,13 +280,9 @@ func (t *taskbar) close(container *gtk.Box) {
 	t.Unref()
 }
 
-func (t *taskbar) events() chan<- *eventv1.Event {
-	return t.eventCh
-}
-
 func (t *taskbar) watch() {
-	ticker := time.NewTicker(time.Second)
-	t.AddRef(ticker.Stop)
+	changeCh, cancel := t.state.Watch()
+	t.AddRef(cancel)
 
 	for {
 		select {
@@ -294,61 +292,22 @@ func (t *taskbar) watch() {
 			select {
 			case <-t.quitCh:
 				return
-			case <-ticker.C:
-			case evt := <-t.eventCh:
-				switch evt.Kind {
-				case eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACE:
-					name, err := eventv1.DataString(evt.Data)
-					if err != nil {
-						log.Warn(`Invalid event`, `module`, style.TaskbarID, `evt`, evt)
-						continue
-					}
-					t.activeWorkspace = name
-				case eventv1.EventKind_EVENT_KIND_HYPR_CLOSEWINDOW:
-					addr, err := eventv1.DataString(evt.Data)
-					if err != nil {
-						log.Warn(`Invalid event`, `module`, style.TaskbarID, `evt`, evt)
-						continue
-					}
-
-					var cb glib.SourceFunc
-					cb = func(uintptr) bool {
-						defer unrefCallback(&cb)
-						if err := t.deleteClient(addr); err != nil {
-							log.Debug(`Failed deleting client`, `module`, style.TaskbarID, `evt`, evt, `err`, err)
-							return false
-						}
-						return false
-					}
-
-					glib.IdleAdd(&cb, 0)
-					continue
-				case eventv1.EventKind_EVENT_KIND_HYPR_ACTIVEWINDOWV2:
-					addr, err := eventv1.DataString(evt.Data)
-					if err != nil {
-						log.Warn(`Invalid event`, `module`, style.TaskbarID, `evt`, evt)
-						continue
-					}
-					t.activeClient = string(addr)
-				case eventv1.EventKind_EVENT_KIND_HYPR_MOVEWORKSPACE:
-				case eventv1.EventKind_EVENT_KIND_HYPR_OPENWINDOW:
-				case eventv1.EventKind_EVENT_KIND_HYPR_WINDOWTITLE:
-				case eventv1.EventKind_EVENT_KIND_HYPR_MOVEWINDOW:
-				default:
+			case change := <-changeCh:
+				if !change.Has(hypripc.ChangeWorkspaces | hypripc.ChangeClients | hypripc.ChangeActive) {
 					continue
 				}
+			}
 
-				var cb glib.SourceFunc
-				cb = func(uintptr) bool {
-					defer unrefCallback(&cb)
-					if err := t.update(); err != nil {
-						log.Debug(`Failed updating`, `module`, style.TaskbarID, `err`, err)
-					}
-					return false
+			var cb glib.SourceFunc
+			cb = func(uintptr) bool {
+				defer unrefCallback(&cb)
+				if err := t.update(); err != nil {
+					log.Debug(`Failed updating`, `module`, style.TaskbarID, `err`, err)
 				}
-
-				glib.IdleAdd(&cb, 0)
+				return false
 			}
+
+			glib.IdleAdd(&cb, 0)
 		}
 	}
 }
@@ -364,7 +323,6 @@ func newTaskbar(cfg *modulev1.Taskbar, a *api) *taskbar {
 		cfg:         cfg,
 		itemSize:    a.panelCfg.Size,
 		itemScale:   1.0,
-		eventCh:     make(chan *eventv1.Event),
 		quitCh:      make(chan struct{}),
 		items:       make(map[string]*taskbarItem),
 		itemClasses: make(map[string]string),
@@ -372,7 +330,6 @@ func newTaskbar(cfg *modulev1.Taskbar, a *api) *taskbar {
 	}
 	t.AddRef(func() {
 		close(t.quitCh)
-		close(t.eventCh)
 	})
 
 	for _, c := range t.cfg.Pinned {
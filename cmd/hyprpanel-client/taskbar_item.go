@@ -495,7 +495,7 @@ func (i *taskbarItem) clientAddress() string {
 }
 
 func (i *taskbarItem) shouldPreview() bool {
-	return i.activeClient != ``
+	return i.activeClient != `` && i.caps.Has(hypripc.CapabilityToplevelExport)
 }
 
 func (i *taskbarItem) build(container *gtk.Box) error {
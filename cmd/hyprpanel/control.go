@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"runtime/debug"
+	"sort"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pdf/hyprpanel/internal/control"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// controlServer exposes runtime control of the host over a Unix socket.
+type controlServer struct {
+	hyprpanelv1.UnimplementedControlServiceServer
+
+	host     *host
+	log      hclog.Logger
+	reload   func() error
+	path     string
+	server   *grpc.Server
+	listener net.Listener
+}
+
+// Status implementation.
+func (s *controlServer) Status(_ context.Context, _ *hyprpanelv1.ControlServiceStatusRequest) (*hyprpanelv1.ControlServiceStatusResponse, error) {
+	h := s.host
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	res := &hyprpanelv1.ControlServiceStatusResponse{
+		Version:      `unknown`,
+		Pid:          int32(os.Getpid()),
+		LogLevel:     h.cfg.LogLevel,
+		DbusEnabled:  h.dbus != nil,
+		AudioEnabled: h.audio != nil,
+		Panels:       make([]*hyprpanelv1.ControlServiceStatusResponse_Panel, 0, len(h.panels)),
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		res.Version = info.Main.Version
+	}
+
+	for _, cfg := range h.panelCfgs {
+		inst, running := h.panels[cfg.Id]
+		hist, failed := h.history[cfg.Id]
+		if !running && !failed {
+			continue
+		}
+		_, hidden := h.hidden[cfg.Id]
+		panel := &hyprpanelv1.ControlServiceStatusResponse_Panel{
+			Id:      cfg.Id,
+			Monitor: cfg.Monitor,
+			Edge:    cfg.Edge,
+			Visible: running && !hidden,
+			Running: running,
+		}
+		if running {
+			panel.DroppedEvents = inst.sub.Dropped()
+		}
+		if failed {
+			panel.Restarts = hist.restarts
+			panel.Failures = uint32(len(hist.failures))
+			panel.Abandoned = hist.abandoned
+			if hist.lastErr != nil {
+				panel.LastError = hist.lastErr.Error()
+			}
+		}
+		res.Panels = append(res.Panels, panel)
+	}
+	sort.Slice(res.Panels, func(i, j int) bool {
+		return res.Panels[i].Id < res.Panels[j].Id
+	})
+
+	return res, nil
+}
+
+// Notify implementation.
+func (s *controlServer) Notify(_ context.Context, req *hyprpanelv1.ControlServiceNotifyRequest) (*hyprpanelv1.ControlServiceNotifyResponse, error) {
+	if req.Event == nil {
+		return &hyprpanelv1.ControlServiceNotifyResponse{}, status.Error(codes.InvalidArgument, `missing event`)
+	}
+	s.log.Debug(`Received control event`, `kind`, req.Event.Kind)
+	s.host.dispatch(req.Event)
+
+	return &hyprpanelv1.ControlServiceNotifyResponse{}, nil
+}
+
+// PanelSetVisible implementation.
+func (s *controlServer) PanelSetVisible(_ context.Context, req *hyprpanelv1.ControlServicePanelSetVisibleRequest) (*hyprpanelv1.ControlServicePanelSetVisibleResponse, error) {
+	if err := s.host.setPanelsVisible(req.Ids, req.Visible); err != nil {
+		return &hyprpanelv1.ControlServicePanelSetVisibleResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &hyprpanelv1.ControlServicePanelSetVisibleResponse{}, nil
+}
+
+// Reload implementation.
+func (s *controlServer) Reload(_ context.Context, _ *hyprpanelv1.ControlServiceReloadRequest) (*hyprpanelv1.ControlServiceReloadResponse, error) {
+	if err := s.reload(); err != nil {
+		return &hyprpanelv1.ControlServiceReloadResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &hyprpanelv1.ControlServiceReloadResponse{}, nil
+}
+
+func (s *controlServer) serve() {
+	if err := s.server.Serve(s.listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		s.log.Error(`Control socket failed`, `err`, err)
+	}
+}
+
+// Close stops the server and removes the socket.
+func (s *controlServer) Close() {
+	s.server.Stop()
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		s.log.Warn(`Failed removing control socket`, `path`, s.path, `err`, err)
+	}
+}
+
+func newControlServer(h *host, path string, reload func() error, log hclog.Logger) (*controlServer, error) {
+	l, err := control.Listen(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &controlServer{
+		host:     h,
+		log:      log,
+		reload:   reload,
+		path:     path,
+		server:   grpc.NewServer(),
+		listener: l,
+	}
+	hyprpanelv1.RegisterControlServiceServer(s.server, s)
+	go s.serve()
+
+	return s, nil
+}
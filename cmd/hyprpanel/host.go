@@ -5,52 +5,107 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
-	"path/filepath"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/disintegration/imaging"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-plugin"
+	"github.com/pdf/hyprpanel/config"
 	"github.com/pdf/hyprpanel/internal/applications"
 	"github.com/pdf/hyprpanel/internal/audio"
+	"github.com/pdf/hyprpanel/internal/control"
 	"github.com/pdf/hyprpanel/internal/dbus"
+	"github.com/pdf/hyprpanel/internal/eventbus"
 	"github.com/pdf/hyprpanel/internal/hypripc"
 	"github.com/pdf/hyprpanel/internal/panelplugin"
 	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
 	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
 	"github.com/pdf/hyprpanel/wl"
-	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
-	clientName    = `hyprpanel-client`
-	layerShellLib = `libgtk4-layer-shell.so`
-	layerShellPkg = `gtk-layer-shell-0`
+	clientName     = `hyprpanel-client`
+	layerShellLib  = `libgtk4-layer-shell.so`
+	layerShellPkg  = `gtk-layer-shell-0`
+	panelQueueSize = 256
+	hyprQueueSize  = 256
+	// notifyWarnInterval limits how often event delivery failures are logged
+	// for each panel.
+	notifyWarnInterval = 10 * time.Second
 )
 
-var (
-	errReload   = fmt.Errorf(`reloading`)
-	errDisabled = fmt.Errorf(`feature disabled`)
-)
+var errDisabled = fmt.Errorf(`feature disabled`)
+
+// panelInstance tracks a running panel plugin and the configuration it was started with.
+type panelInstance struct {
+	panelplugin.Panel
+	cfg    *configv1.Panel
+	client *plugin.Client
+	sub    *eventbus.Subscription
+	kinds  []eventv1.EventKind
+	filter bool
+	stopCh chan struct{}
+}
+
+// consumes reports whether the panel receives any of the specified event kinds.
+func (p *panelInstance) consumes(kinds ...eventv1.EventKind) bool {
+	if !p.filter {
+		return len(kinds) > 0
+	}
+
+	return slices.ContainsFunc(p.kinds, func(kind eventv1.EventKind) bool {
+		return slices.Contains(kinds, kind)
+	})
+}
+
+// stop closes the panel and terminates the plugin process without reporting a failure.
+func (p *panelInstance) stop() {
+	close(p.stopCh)
+	p.sub.Close()
+	p.Close()
+	p.client.Kill()
+}
 
 type host struct {
-	cfg         *configv1.Config
-	stylesheet  []byte
-	log         hclog.Logger
-	pluginLog   hclog.Logger
-	wl          *wl.App
-	hypr        *hypripc.HyprIPC
-	hyprEvtCh   <-chan *eventv1.Event
-	dbus        *dbus.Client
-	dbusEvtCh   <-chan *eventv1.Event
-	audio       *audio.Client
-	audioEvtCh  <-chan *eventv1.Event
-	apps        *applications.AppCache
-	panels      []panelplugin.Panel
-	reloadCh    chan struct{}
-	stopWatchCh chan struct{}
-	quitCh      chan struct{}
+	cfg            *configv1.Config
+	stylesheet     []byte
+	log            hclog.Logger
+	pluginLog      hclog.Logger
+	wl             *wl.App
+	hypr           *hypripc.HyprIPC
+	caps           *hypripc.Capabilities
+	hyprEvtCh      <-chan *eventv1.Event
+	dbus           *dbus.Client
+	dbusEvtCh      <-chan *eventv1.Event
+	audio          *audio.Client
+	audioEvtCh     <-chan *eventv1.Event
+	recorder       *recorder
+	replayer       *replayer
+	apps           *applications.AppCache
+	clientPath     string
+	layerShellPath string
+	prevPreload    string
+	monitors       []string
+	panelCfgs      []*configv1.Panel
+	panels         map[string]*panelInstance
+	bus            *eventbus.Bus
+	snapshot       *snapshot
+	snapshotMu     sync.Mutex
+	history        map[string]*panelHistory
+	hidden         map[string]struct{}
+	mu             sync.RWMutex
+	configCh       chan *configv1.Config
+	styleCh        chan []byte
+	failCh         chan panelFailure
+	restartCh      chan string
+	monitorCh      chan struct{}
+	stopWatchCh    chan struct{}
+	watchDoneCh    chan struct{}
+	quitCh         chan struct{}
 }
 
 func (h *host) Exec(action *hyprpanelv1.AppInfo_Action) error {
@@ -61,9 +116,12 @@ func (h *host) Exec(action *hyprpanelv1.AppInfo_Action) error {
 		c    string
 		args []string
 	)
-	if len(h.cfg.LaunchWrapper) > 0 {
-		c = h.cfg.LaunchWrapper[0]
-		args = append(h.cfg.LaunchWrapper[1:], action.Exec...)
+	h.mu.RLock()
+	wrapper := h.cfg.LaunchWrapper
+	h.mu.RUnlock()
+	if len(wrapper) > 0 {
+		c = wrapper[0]
+		args = append(slices.Clone(wrapper[1:]), action.Exec...)
 	} else {
 		c = action.Exec[0]
 		args = action.Exec[1:]
@@ -77,109 +135,173 @@ func (h *host) Exec(action *hyprpanelv1.AppInfo_Action) error {
 }
 
 func (h *host) FindApplication(query string) (*hyprpanelv1.AppInfo, error) {
-	return h.apps.Find(query), nil
+	h.mu.RLock()
+	apps := h.apps
+	h.mu.RUnlock()
+
+	return apps.Find(query), nil
 }
 
 func (h *host) SystrayActivate(busName string, x, y int32) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
+	client, err := h.dbusClient()
+	if err != nil {
+		return err
+	}
+	systray := client.Systray()
+	if systray == nil {
 		return errDisabled
 	}
-	return h.dbus.Systray().Activate(busName, x, y)
+
+	return systray.Activate(busName, x, y)
 }
 
 func (h *host) SystraySecondaryActivate(busName string, x, y int32) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
+	client, err := h.dbusClient()
+	if err != nil {
+		return err
+	}
+	systray := client.Systray()
+	if systray == nil {
 		return errDisabled
 	}
-	return h.dbus.Systray().SecondaryActivate(busName, x, y)
+
+	return systray.SecondaryActivate(busName, x, y)
 }
 
 func (h *host) SystrayScroll(busName string, delta int32, orientation hyprpanelv1.SystrayScrollOrientation) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
+	client, err := h.dbusClient()
+	if err != nil {
+		return err
+	}
+	systray := client.Systray()
+	if systray == nil {
 		return errDisabled
 	}
-	return h.dbus.Systray().Scroll(busName, delta, orientation)
+
+	return systray.Scroll(busName, delta, orientation)
 }
 
 func (h *host) SystrayMenuContextActivate(busName string, x, y int32) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
+	client, err := h.dbusClient()
+	if err != nil {
+		return err
+	}
+	systray := client.Systray()
+	if systray == nil {
 		return errDisabled
 	}
-	return h.dbus.Systray().MenuContextActivate(busName, x, y)
+
+	return systray.MenuContextActivate(busName, x, y)
 }
 
 func (h *host) SystrayMenuAboutToShow(busName string, menuItemID string) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
+	client, err := h.dbusClient()
+	if err != nil {
+		return err
+	}
+	systray := client.Systray()
+	if systray == nil {
 		return errDisabled
 	}
-	return h.dbus.Systray().MenuAboutToShow(busName, menuItemID)
+
+	return systray.MenuAboutToShow(busName, menuItemID)
 }
 
 func (h *host) SystrayMenuEvent(busName string, id int32, eventID hyprpanelv1.SystrayMenuEvent, data any, timestamp time.Time) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
+	client, err := h.dbusClient()
+	if err != nil {
+		return err
+	}
+	systray := client.Systray()
+	if systray == nil {
 		return errDisabled
 	}
-	return h.dbus.Systray().MenuEvent(busName, id, eventID, data, timestamp)
+
+	return systray.MenuEvent(busName, id, eventID, data, timestamp)
 }
 
 func (h *host) NotificationClosed(id uint32, reason hyprpanelv1.NotificationClosedReason) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
+	client, err := h.dbusClient()
+	if err != nil {
+		return err
+	}
+	notification := client.Notification()
+	if notification == nil {
 		return errDisabled
 	}
-	return h.dbus.Notification().Closed(id, reason)
+
+	return notification.Closed(id, reason)
 }
 
 func (h *host) NotificationAction(id uint32, actionKey string) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
+	client, err := h.dbusClient()
+	if err != nil {
+		return err
+	}
+	notification := client.Notification()
+	if notification == nil {
 		return errDisabled
 	}
-	return h.dbus.Notification().Action(id, actionKey)
+
+	return notification.Action(id, actionKey)
 }
 
 func (h *host) AudioSinkVolumeAdjust(id string, direction eventv1.Direction) error {
-	if h.cfg.Audio == nil || !h.cfg.Audio.Enabled {
-		return errDisabled
+	client, err := h.audioClient()
+	if err != nil {
+		return err
 	}
 
-	return h.audio.SinkVolumeAdjust(id, direction)
+	return client.SinkVolumeAdjust(id, direction)
 }
 
 func (h *host) AudioSinkMuteToggle(id string) error {
-	if h.cfg.Audio == nil || !h.cfg.Audio.Enabled {
-		return errDisabled
+	client, err := h.audioClient()
+	if err != nil {
+		return err
 	}
 
-	return h.audio.SinkMuteToggle(id)
+	return client.SinkMuteToggle(id)
 }
 
 func (h *host) AudioSourceVolumeAdjust(id string, direction eventv1.Direction) error {
-	if h.cfg.Audio == nil || !h.cfg.Audio.Enabled {
-		return errDisabled
+	client, err := h.audioClient()
+	if err != nil {
+		return err
 	}
 
-	return h.audio.SourceVolumeAdjust(id, direction)
+	return client.SourceVolumeAdjust(id, direction)
 }
 
 func (h *host) AudioSourceMuteToggle(id string) error {
-	if h.cfg.Audio == nil || !h.cfg.Audio.Enabled {
-		return errDisabled
+	client, err := h.audioClient()
+	if err != nil {
+		return err
 	}
 
-	return h.audio.SourceMuteToggle(id)
+	return client.SourceMuteToggle(id)
 }
 
 func (h *host) BrightnessAdjust(devName string, direction eventv1.Direction) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.cfg.Dbus.Brightness == nil || !h.cfg.Dbus.Brightness.Enabled {
+	client, err := h.dbusClient()
+	if err != nil {
+		return err
+	}
+	brightness := client.Brightness()
+	if brightness == nil {
 		return errDisabled
 	}
 
-	return h.dbus.Brightness().Adjust(devName, direction)
+	return brightness.Adjust(devName, direction)
 }
 
 func (h *host) CaptureFrame(address uint64, width, height int32) (*hyprpanelv1.ImageNRGBA, error) {
 	if h.wl == nil {
 		return nil, fmt.Errorf(`wl app not available`)
 	}
+	if !h.caps.Has(hypripc.CapabilityToplevelExport) {
+		return nil, fmt.Errorf(`toplevel export not supported by compositor`)
+	}
 
 	if address == 0 || width == 0 || height == 0 {
 		return nil, fmt.Errorf("invalid parameters: address=%d, width=%d, height=%d", address, width, height)
@@ -200,11 +322,11 @@ func (h *host) CaptureFrame(address uint64, width, height int32) (*hyprpanelv1.I
 	}, nil
 }
 
-func (h *host) runPanel(clientPath string, layerShellPath string, prevPreload string, id string, cfg *configv1.Panel) (panelplugin.Panel, *plugin.Client, error) {
+func (h *host) runPanel(clientPath string, layerShellPath string, prevPreload string, id string, cfg *configv1.Panel) (_ panelplugin.Panel, _ *plugin.Client, _ []eventv1.EventKind, filter bool, err error) {
 	socketDir := os.Getenv(plugin.EnvUnixSocketDir)
 	if socketDir == `` {
-		if runDir := os.Getenv(`XDG_RUNTIME_DIR`); runDir != `` {
-			socketDir = filepath.Join(runDir, `hyprpanel`)
+		if runDir, err := control.RuntimeDir(); err == nil {
+			socketDir = runDir
 			if err := os.MkdirAll(socketDir, 0o750); err != nil && err != os.ErrExist {
 				h.log.Warn(`Could not create socket dir`, `path`, socketDir, `err`, err)
 			} else {
@@ -223,51 +345,514 @@ func (h *host) runPanel(clientPath string, layerShellPath string, prevPreload st
 		Managed:             true,
 		GRPCBrokerMultiplex: true,
 	})
+	defer func() {
+		if err != nil {
+			client.Kill()
+		}
+	}()
 
 	if err := os.Setenv(`LD_PRELOAD`, layerShellPath); err != nil {
-		return nil, nil, fmt.Errorf(`failed to set LD_PRELOAD: %w`, err)
+		return nil, nil, nil, false, fmt.Errorf(`failed to set LD_PRELOAD: %w`, err)
 	}
 	rpcClient, err := client.Client()
-	if err != nil {
-		return nil, nil, fmt.Errorf(`failed initializing client: %w`, err)
+	// LD_PRELOAD must only apply to the client, restore it before handling
+	// errors.
+	if restoreErr := os.Setenv(`LD_PRELOAD`, prevPreload); restoreErr != nil && err == nil {
+		err = fmt.Errorf(`failed to restore LD_PRELOAD: %w`, restoreErr)
+	} else if err != nil {
+		err = fmt.Errorf(`failed initializing client: %w`, err)
 	}
-	if err := os.Setenv(`LD_PRELOAD`, prevPreload); err != nil {
-		return nil, nil, fmt.Errorf(`failed to restore LD_PRELOAD: %w`, err)
+	if err != nil {
+		return nil, nil, nil, false, err
 	}
 
 	raw, err := rpcClient.Dispense(panelplugin.PanelPluginName)
 	if err != nil {
-		return nil, nil, fmt.Errorf(`failed dispensing client: %w`, err)
+		return nil, nil, nil, false, fmt.Errorf(`failed dispensing client: %w`, err)
 	}
 
 	panel := raw.(panelplugin.Panel)
-	if err := panel.Init(h, id, h.cfg.LogLevel, cfg, h.stylesheet); err != nil {
-		return nil, nil, err
+	var hyprSnapshot *eventv1.HyprSnapshotValue
+	if h.replayer != nil {
+		hyprSnapshot = h.replayer.snapshot
+	}
+	kinds, filter, err := panel.Init(h, id, h.cfg.LogLevel, cfg, h.stylesheet, hyprSnapshot)
+	if err != nil {
+		return nil, nil, nil, false, err
 	}
 
-	return panel, client, nil
+	return panel, client, kinds, filter, nil
 }
 
 func (h *host) updateConfig(cfg *configv1.Config) {
-	h.cfg = cfg
-	h.reloadCh <- struct{}{}
+	select {
+	case h.configCh <- cfg:
+	case <-h.quitCh:
+	}
 }
 
 func (h *host) updateStyle(stylesheet []byte) {
+	select {
+	case h.styleCh <- stylesheet:
+	case <-h.quitCh:
+	}
+}
+
+// startPanel launches a panel plugin, and reports unexpected exits on failCh.
+func (h *host) startPanel(cfg *configv1.Panel) error {
+	panel, client, kinds, filter, err := h.runPanel(h.clientPath, h.layerShellPath, h.prevPreload, cfg.Id, cfg)
+	if err != nil {
+		return fmt.Errorf("panel %s initialization failed: %w", cfg.Id, err)
+	}
+
+	inst := &panelInstance{
+		Panel:  panel,
+		cfg:    cfg,
+		client: client,
+		kinds:  kinds,
+		filter: filter,
+		stopCh: make(chan struct{}),
+	}
+	// Subscribe and take the snapshot together, so that each event is
+	// delivered exactly once, either replayed or from the subscription.
+	opts := []eventbus.Option{eventbus.WithName(cfg.Id), eventbus.WithQueueSize(panelQueueSize)}
+	if filter {
+		opts = append(opts, eventbus.WithKinds(kinds...))
+	}
+	h.snapshotMu.Lock()
+	inst.sub = h.bus.Subscribe(opts...)
+	replay := h.snapshot.events(filter, kinds...)
+	h.snapshotMu.Unlock()
+	var (
+		lastWarn   time.Time
+		suppressed int
+	)
+	notify := func(evt *eventv1.Event) {
+		err := inst.Notify(evt)
+		if err == nil {
+			return
+		}
+		if time.Since(lastWarn) < notifyWarnInterval {
+			suppressed++
+			return
+		}
+		h.log.Warn(`Failed delivering event to panel`, `panelID`, cfg.Id, `evt`, evt.Kind.String(), `err`, err, `suppressed`, suppressed)
+		lastWarn, suppressed = time.Now(), 0
+	}
+	go func() {
+		for _, evt := range replay {
+			notify(evt)
+		}
+		for evt := range inst.sub.Events() {
+			notify(evt)
+		}
+	}()
+	h.mu.Lock()
+	h.panels[cfg.Id] = inst
+	delete(h.hidden, cfg.Id)
+	h.mu.Unlock()
+
+	ctx := panel.Context()
+	go func() {
+		select {
+		case <-inst.stopCh:
+			return
+		case <-ctx.Done():
+		}
+
+		select {
+		case h.failCh <- panelFailure{inst: inst, err: context.Cause(ctx)}:
+		case <-inst.stopCh:
+		case <-h.quitCh:
+		}
+	}()
+
+	return nil
+}
+
+// stopPanel terminates the panel identified by id, if it is running.
+func (h *host) stopPanel(id string) {
+	h.mu.Lock()
+	inst, ok := h.panels[id]
+	delete(h.panels, id)
+	delete(h.hidden, id)
+	h.mu.Unlock()
+
+	if ok {
+		inst.stop()
+	}
+}
+
+// stopPanels terminates all running panels.
+func (h *host) stopPanels() {
+	h.mu.Lock()
+	panels := h.panels
+	h.panels = make(map[string]*panelInstance)
+	h.hidden = make(map[string]struct{})
+	h.mu.Unlock()
+
+	for _, inst := range panels {
+		inst.stop()
+	}
+}
+
+// applyConfig diffs cfg against the running configuration, and restarts only the
+// subsystems and panels that are affected by the change.
+func (h *host) applyConfig(cfg *configv1.Config) error {
+	prev := h.cfg
+	levelChanged := prev.LogLevel != cfg.LogLevel
+	dbusChanged := !proto.Equal(prev.Dbus, cfg.Dbus)
+	audioChanged := !proto.Equal(prev.Audio, cfg.Audio)
+	iconsChanged := !slices.EqualFunc(prev.IconOverrides, cfg.IconOverrides, func(a, b *configv1.IconOverride) bool {
+		return proto.Equal(a, b)
+	})
+
+	h.log.SetLevel(hclog.Level(cfg.LogLevel))
+	h.pluginLog.SetLevel(hclog.Level(cfg.LogLevel))
+
+	// Features of a connected D-Bus client are reconfigured in place, only
+	// enabling or disabling D-Bus, or retrying a failed connection, requires
+	// a new client.
+	h.mu.RLock()
+	dbusReconnect := dbusChanged && (prev.Dbus.GetEnabled() != cfg.Dbus.GetEnabled() || h.dbus == nil)
+	h.mu.RUnlock()
+
+	if dbusReconnect || audioChanged {
+		h.stopWatch()
+		defer h.startWatch()
+	}
+
+	h.mu.Lock()
+	h.cfg = cfg
+	h.mu.Unlock()
+
+	if iconsChanged {
+		h.log.Info(`Icon overrides changed, rebuilding app cache`)
+		apps, err := applications.New(h.log, cfg.IconOverrides)
+		if err != nil {
+			return fmt.Errorf("app cache initialization failed: %w", err)
+		}
+		h.mu.Lock()
+		prevApps := h.apps
+		h.apps = apps
+		h.mu.Unlock()
+		if err := prevApps.Close(); err != nil {
+			h.log.Error(`Failed to close app cache`, `err`, err)
+		}
+	}
+
+	// Event kinds whose state was discarded, which panels consuming them
+	// must be restarted to drop.
+	var discarded []eventv1.EventKind
+
+	switch {
+	case dbusReconnect:
+		h.log.Info(`DBUS configuration changed, reconnecting`)
+		// A failed connection disables DBUS features, rather than stopping
+		// all panels.
+		if err := h.reconnectDBUS(); err != nil {
+			h.log.Error(`DBUS connection failed, DBUS features disabled`, `err`, err)
+		}
+		discarded = append(discarded, dbus.StateKinds...)
+	case dbusChanged:
+		h.log.Info(`DBUS configuration changed, reconfiguring`)
+		kinds, err := h.reconfigureDBUS(cfg.Dbus)
+		if err != nil {
+			h.log.Error(`DBUS reconfiguration failed, some DBUS features disabled`, `err`, err)
+		}
+		discarded = append(discarded, kinds...)
+	}
+
+	if audioChanged {
+		h.log.Info(`Audio configuration changed, reconnecting`)
+		if err := h.reconnectAudio(); err != nil {
+			h.log.Error(`Audio connection failed, audio features disabled`, `err`, err)
+		}
+		discarded = append(discarded, audio.StateKinds...)
+	}
+
+	if levelChanged {
+		h.applyLogLevel(cfg.LogLevel)
+	}
+
+	restart := func(inst *panelInstance) bool {
+		return inst.consumes(discarded...)
+	}
+
+	prevPanels, nextPanels := h.expandPanels(cfg)
+
+	h.reconcilePanels(prevPanels, nextPanels, restart)
+
+	return nil
+}
+
+// reconcilePanels stops panels that were removed or changed between prevPanels
+// and nextPanels, and starts any that are not running. Running panels are also
+// restarted if restart is non-nil and reports true.
+func (h *host) reconcilePanels(prevPanels, nextPanels []*configv1.Panel, restart func(*panelInstance) bool) {
+	next := make(map[string]*configv1.Panel, len(nextPanels))
+	for _, panelCfg := range nextPanels {
+		next[panelCfg.Id] = panelCfg
+	}
+
+	h.mu.RLock()
+	var stale []string
+	for id, inst := range h.panels {
+		if panelCfg, ok := next[id]; !ok || !proto.Equal(inst.cfg, panelCfg) || (restart != nil && restart(inst)) {
+			stale = append(stale, id)
+		}
+	}
+	h.mu.RUnlock()
+
+	// Changed panels are given a fresh failure budget.
+	for _, panelCfg := range prevPanels {
+		if nextCfg, ok := next[panelCfg.Id]; !ok || !proto.Equal(panelCfg, nextCfg) || slices.Contains(stale, panelCfg.Id) {
+			h.resetHistory(panelCfg.Id)
+		}
+	}
+
+	for _, id := range stale {
+		h.log.Info(`Stopping panel`, `id`, id)
+		h.stopPanel(id)
+	}
+
+	for _, panelCfg := range nextPanels {
+		h.mu.RLock()
+		_, running := h.panels[panelCfg.Id]
+		h.mu.RUnlock()
+		if running || h.abandoned(panelCfg.Id) {
+			continue
+		}
+		h.log.Info(`Starting panel`, `id`, panelCfg.Id)
+		h.launchPanel(panelCfg)
+	}
+}
+
+// expandPanels replaces the running panel configurations with the panels from
+// cfg, instantiating templates for the connected monitors, and returns the
+// previous and next panel configurations.
+func (h *host) expandPanels(cfg *configv1.Config) ([]*configv1.Panel, []*configv1.Panel) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	prev := h.panelCfgs
+	h.panelCfgs = config.ExpandPanels(cfg.Panels, h.monitors)
+
+	return prev, h.panelCfgs
+}
+
+// applyMonitors instantiates and destroys template panels to match the
+// connected monitors, leaving all other panels running.
+func (h *host) applyMonitors() {
+	if err := h.updateMonitors(); err != nil {
+		h.log.Warn(`Failed querying monitors`, `err`, err)
+		return
+	}
+
+	prevPanels, nextPanels := h.expandPanels(h.cfg)
+
+	h.reconcilePanels(prevPanels, nextPanels, nil)
+}
+
+// applyLogLevel pushes the log level to all running panels, treating any panel
+// that fails to apply it as failed.
+func (h *host) applyLogLevel(level configv1.LogLevel) {
+	h.mu.RLock()
+	panels := make([]*panelInstance, 0, len(h.panels))
+	for _, inst := range h.panels {
+		panels = append(panels, inst)
+	}
+	h.mu.RUnlock()
+
+	for _, inst := range panels {
+		if err := inst.SetLogLevel(level); err != nil {
+			h.panelFailed(inst.cfg.Id, fmt.Errorf("log level update failed: %w", err))
+		}
+	}
+}
+
+// applyStyle pushes the new stylesheet to all running panels, treating any
+// panel that fails to apply it as failed.
+func (h *host) applyStyle(stylesheet []byte) {
+	h.mu.Lock()
 	h.stylesheet = stylesheet
-	h.reloadCh <- struct{}{}
+	panels := make([]*panelInstance, 0, len(h.panels))
+	for _, inst := range h.panels {
+		panels = append(panels, inst)
+	}
+	h.mu.Unlock()
+
+	for _, inst := range panels {
+		if err := inst.UpdateStyle(stylesheet); err != nil {
+			h.panelFailed(inst.cfg.Id, fmt.Errorf("stylesheet update failed: %w", err))
+		}
+	}
+}
+
+// dispatch handles action events on the host, and forwards all other events to panels.
+func (h *host) dispatch(evt *eventv1.Event) {
+	switch evt.Kind {
+	case eventv1.EventKind_EVENT_KIND_AUDIO_SINK_VOLUME_ADJUST:
+		data := &eventv1.AudioSinkVolumeAdjust{}
+		if !evt.Data.MessageIs(data) {
+			h.log.Warn(`Invalid event`, `evt`, evt)
+			return
+		}
+		if err := evt.Data.UnmarshalTo(data); err != nil {
+			h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
+			return
+		}
+		if err := h.AudioSinkVolumeAdjust(data.Id, data.Direction); err != nil {
+			h.log.Warn(`Audio sink volume adjustment failed`, `err`, err)
+		}
+	case eventv1.EventKind_EVENT_KIND_AUDIO_SINK_MUTE_TOGGLE:
+		data := &eventv1.AudioSinkMuteToggle{}
+		if !evt.Data.MessageIs(data) {
+			h.log.Warn(`Invalid event`, `evt`, evt)
+			return
+		}
+		if err := evt.Data.UnmarshalTo(data); err != nil {
+			h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
+			return
+		}
+		if err := h.AudioSinkMuteToggle(data.Id); err != nil {
+			h.log.Warn(`Audio sink mute toggle failed`, `err`, err)
+		}
+	case eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_VOLUME_ADJUST:
+		data := &eventv1.AudioSourceVolumeAdjust{}
+		if !evt.Data.MessageIs(data) {
+			h.log.Warn(`Invalid event`, `evt`, evt)
+			return
+		}
+		if err := evt.Data.UnmarshalTo(data); err != nil {
+			h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
+			return
+		}
+		if err := h.AudioSourceVolumeAdjust(data.Id, data.Direction); err != nil {
+			h.log.Warn(`Audio source volume adjustment failed`, `err`, err)
+		}
+	case eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_MUTE_TOGGLE:
+		data := &eventv1.AudioSourceMuteToggle{}
+		if !evt.Data.MessageIs(data) {
+			h.log.Warn(`Invalid event`, `evt`, evt)
+			return
+		}
+		if err := evt.Data.UnmarshalTo(data); err != nil {
+			h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
+			return
+		}
+		if err := h.AudioSourceMuteToggle(data.Id); err != nil {
+			h.log.Warn(`Audio source mute toggle failed`, `err`, err)
+		}
+	case eventv1.EventKind_EVENT_KIND_DBUS_BRIGHTNESS_ADJUST:
+		data := &eventv1.BrightnessAdjustValue{}
+		if !evt.Data.MessageIs(data) {
+			h.log.Warn(`Invalid event`, `evt`, evt)
+			return
+		}
+		if err := evt.Data.UnmarshalTo(data); err != nil {
+			h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
+			return
+		}
+		if err := h.BrightnessAdjust(data.DevName, data.Direction); err != nil {
+			h.log.Warn(`Brightness adjustment failed`, `err`, err)
+		}
+	case eventv1.EventKind_EVENT_KIND_EXEC:
+		data := &hyprpanelv1.AppInfo_Action{}
+		if !evt.Data.MessageIs(data) {
+			h.log.Warn(`Invalid event`, `evt`, evt)
+			return
+		}
+		if err := evt.Data.UnmarshalTo(data); err != nil {
+			h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
+			return
+		}
+		if err := h.Exec(data); err != nil {
+			h.log.Warn(`Exec failed`, `err`, err)
+		}
+	default:
+		h.broadcast(evt)
+	}
+}
+
+// broadcast queues an event for delivery to all running panels, and records
+// it for replay to panels started later.
+func (h *host) broadcast(evt *eventv1.Event) {
+	if h.recorder != nil {
+		if err := h.recorder.record(evt); err != nil {
+			h.log.Warn(`Failed recording event`, `evt`, evt.Kind.String(), `err`, err)
+		}
+	}
+
+	h.snapshotMu.Lock()
+	defer h.snapshotMu.Unlock()
+	h.snapshot.record(evt)
+	h.bus.Publish(evt)
 }
 
-func (h *host) watch() {
+// setPanelsVisible shows or hides the panels identified by ids, or all panels if ids is empty.
+func (h *host) setPanelsVisible(ids []string, visible bool) error {
+	// Panels are resolved under the lock, but not called with it held, as
+	// panels may call back into the host.
+	h.mu.RLock()
+	if len(ids) == 0 {
+		ids = make([]string, 0, len(h.panels))
+		for id := range h.panels {
+			ids = append(ids, id)
+		}
+	}
+	panels := make([]*panelInstance, 0, len(ids))
+	for _, id := range ids {
+		panel, ok := h.panels[id]
+		if !ok {
+			h.mu.RUnlock()
+			return fmt.Errorf("panel not found: %s", id)
+		}
+		panels = append(panels, panel)
+	}
+	h.mu.RUnlock()
+
+	for i, panel := range panels {
+		if err := panel.SetVisible(visible); err != nil {
+			return fmt.Errorf("panel %s visibility change failed: %w", ids[i], err)
+		}
+
+		h.mu.Lock()
+		// Restarted panels are visible, and no longer track the hidden state.
+		if h.panels[ids[i]] == panel {
+			if visible {
+				delete(h.hidden, ids[i])
+			} else {
+				h.hidden[ids[i]] = struct{}{}
+			}
+		}
+		h.mu.Unlock()
+	}
+
+	return nil
+}
+
+func (h *host) startWatch() {
+	h.stopWatchCh = make(chan struct{})
+	h.watchDoneCh = make(chan struct{})
+	go h.watch(h.stopWatchCh, h.watchDoneCh)
+}
+
+func (h *host) stopWatch() {
+	close(h.stopWatchCh)
+	<-h.watchDoneCh
+}
+
+func (h *host) watch(stopCh <-chan struct{}, doneCh chan<- struct{}) {
+	defer close(doneCh)
 	for {
 		select {
-		case <-h.stopWatchCh:
+		case <-stopCh:
 			return
 		case <-h.quitCh:
 			return
 		default:
 			select {
-			case <-h.stopWatchCh:
+			case <-stopCh:
 				return
 			case <-h.quitCh:
 				return
@@ -277,108 +862,30 @@ func (h *host) watch() {
 					return
 				}
 				h.log.Trace(`Received hypr event`, `kind`, evt.Kind)
-				for _, panel := range h.panels {
-					panel.Notify(evt)
+				switch evt.Kind {
+				case eventv1.EventKind_EVENT_KIND_HYPR_MONITORADDED, eventv1.EventKind_EVENT_KIND_HYPR_MONITORREMOVED,
+					eventv1.EventKind_EVENT_KIND_HYPR_MONITORADDEDV2, eventv1.EventKind_EVENT_KIND_HYPR_MONITORREMOVEDV2,
+					eventv1.EventKind_EVENT_KIND_HYPR_RECONNECTED:
+					select {
+					case h.monitorCh <- struct{}{}:
+					default:
+					}
 				}
+				h.broadcast(evt)
 			case evt, ok := <-h.dbusEvtCh:
 				if !ok || evt == nil {
 					h.log.Error(`Received from closed dbus event channel`)
 					return
 				}
 				h.log.Trace(`Received dbus event`, `kind`, evt.Kind)
-				switch evt.Kind {
-				case eventv1.EventKind_EVENT_KIND_AUDIO_SINK_VOLUME_ADJUST:
-					data := &eventv1.AudioSinkVolumeAdjust{}
-					if !evt.Data.MessageIs(data) {
-						h.log.Warn(`Invalid event`, `evt`, evt)
-						continue
-					}
-					if err := evt.Data.UnmarshalTo(data); err != nil {
-						h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
-						continue
-					}
-					if err := h.AudioSinkVolumeAdjust(data.Id, data.Direction); err != nil {
-						h.log.Warn(`Audio sink volume adjustment failed`, `err`, err)
-					}
-				case eventv1.EventKind_EVENT_KIND_AUDIO_SINK_MUTE_TOGGLE:
-					data := &eventv1.AudioSinkMuteToggle{}
-					if !evt.Data.MessageIs(data) {
-						h.log.Warn(`Invalid event`, `evt`, evt)
-						continue
-					}
-					if err := evt.Data.UnmarshalTo(data); err != nil {
-						h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
-						continue
-					}
-					if err := h.AudioSinkMuteToggle(data.Id); err != nil {
-						h.log.Warn(`Audio sink mute toggle failed`, `err`, err)
-					}
-				case eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_VOLUME_ADJUST:
-					data := &eventv1.AudioSourceVolumeAdjust{}
-					if !evt.Data.MessageIs(data) {
-						h.log.Warn(`Invalid event`, `evt`, evt)
-						continue
-					}
-					if err := evt.Data.UnmarshalTo(data); err != nil {
-						h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
-						continue
-					}
-					if err := h.AudioSourceVolumeAdjust(data.Id, data.Direction); err != nil {
-						h.log.Warn(`Audio source volume adjustment failed`, `err`, err)
-					}
-				case eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_MUTE_TOGGLE:
-					data := &eventv1.AudioSourceMuteToggle{}
-					if !evt.Data.MessageIs(data) {
-						h.log.Warn(`Invalid event`, `evt`, evt)
-						continue
-					}
-					if err := evt.Data.UnmarshalTo(data); err != nil {
-						h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
-						continue
-					}
-					if err := h.AudioSourceMuteToggle(data.Id); err != nil {
-						h.log.Warn(`Audio source mute toggle failed`, `err`, err)
-					}
-				case eventv1.EventKind_EVENT_KIND_DBUS_BRIGHTNESS_ADJUST:
-					data := &eventv1.BrightnessAdjustValue{}
-					if !evt.Data.MessageIs(data) {
-						h.log.Warn(`Invalid event`, `evt`, evt)
-						continue
-					}
-					if err := evt.Data.UnmarshalTo(data); err != nil {
-						h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
-						continue
-					}
-					if err := h.BrightnessAdjust(data.DevName, data.Direction); err != nil {
-						h.log.Warn(`Brightness adjustment failed`, `err`, err)
-					}
-				case eventv1.EventKind_EVENT_KIND_EXEC:
-					data := &hyprpanelv1.AppInfo_Action{}
-					if !evt.Data.MessageIs(data) {
-						h.log.Warn(`Invalid event`, `evt`, evt)
-						continue
-					}
-					if err := evt.Data.UnmarshalTo(data); err != nil {
-						h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
-						continue
-					}
-					if err := h.Exec(data); err != nil {
-						h.log.Warn(`Exec failed`, `err`, err)
-					}
-				default:
-					for _, panel := range h.panels {
-						panel.Notify(evt)
-					}
-				}
+				h.dispatch(evt)
 			case evt, ok := <-h.audioEvtCh:
 				if !ok || evt == nil {
 					h.log.Error(`Received from closed audio event channel`)
 					return
 				}
 				h.log.Trace(`Received audio event`, `kind`, evt.Kind)
-				for _, panel := range h.panels {
-					panel.Notify(evt)
-				}
+				h.broadcast(evt)
 			}
 		}
 	}
@@ -401,18 +908,16 @@ func (h *host) run() error {
 	h.log.SetLevel(hclog.Level(h.cfg.LogLevel))
 	h.pluginLog.SetLevel(hclog.Level(h.cfg.LogLevel))
 
-	clientPath, err := findClient()
+	h.clientPath, err = findClient()
 	if err != nil {
 		return fmt.Errorf("could not find client path: %w", err)
 	}
 
-	layerShellPath, err := findLayerShell()
+	h.layerShellPath, err = findLayerShell()
 	if err != nil {
 		return fmt.Errorf("could not find gtk4-layer-shell path: %w", err)
 	}
 
-	grp, errCtx := errgroup.WithContext(context.Background())
-
 	if h.apps != nil {
 		if err := h.apps.Close(); err != nil {
 			h.log.Error(`Failed to close app cache`, `err`, err)
@@ -428,66 +933,97 @@ func (h *host) run() error {
 	if err := h.connectDBUS(); err != nil {
 		return fmt.Errorf("DBUS connection failed: %w", err)
 	}
-	if h.dbus != nil {
-		defer func() {
-			if err := h.dbus.Close(); err != nil {
-				h.log.Error(`Failed to close dbus client`, `err`, err)
-			}
-		}()
-	}
+	defer h.closeDBUS()
 
 	if err := h.connectAudio(); err != nil {
 		return fmt.Errorf("audio connection failed: %w", err)
 	}
-	if h.audio != nil {
-		defer func() {
-			if err := h.audio.Close(); err != nil {
-				h.log.Error(`Failed to close audio client`, `err`, err)
-			}
-		}()
+	defer h.closeAudio()
+
+	if err := h.updateMonitors(); err != nil {
+		h.log.Warn(`Failed querying monitors`, `err`, err)
 	}
+	h.checkMonitors(h.cfg)
 
-	prevPreload := os.Getenv(`LD_PRELOAD`)
-	h.panels = make([]panelplugin.Panel, len(h.cfg.Panels))
-	for i := range h.cfg.Panels {
-		cfg := h.cfg.Panels[i]
+	h.prevPreload = os.Getenv(`LD_PRELOAD`)
+	defer h.stopPanels()
+	_, panelCfgs := h.expandPanels(h.cfg)
+	for _, cfg := range panelCfgs {
+		h.launchPanel(cfg)
+	}
 
-		panel, _, err := h.runPanel(clientPath, layerShellPath, prevPreload, cfg.Id, cfg)
-		if err != nil {
-			return fmt.Errorf("panel %s initialization failed: %w", cfg.Id, err)
-		}
-		h.panels[i] = panel
-		defer panel.Close()
+	h.startWatch()
+	defer h.stopWatch()
 
-		ctx := panel.Context()
-		grp.Go(func() error {
-			<-ctx.Done()
-			return fmt.Errorf("panel %s failed: %w", cfg.Id, panel.Context().Err())
-		})
+	if h.replayer != nil {
+		replayStopCh := make(chan struct{})
+		defer close(replayStopCh)
+		go func() {
+			if err := h.replayer.run(replayStopCh); err != nil {
+				h.log.Error(`Replay failed`, `file`, h.replayer.path, `err`, err)
+			}
+		}()
 	}
 
-	go h.watch()
-
-	select {
-	case <-h.reloadCh:
-		h.stopWatchCh <- struct{}{}
-		for _, panel := range h.panels {
-			panel.Close()
-		}
-		h.panels = make([]panelplugin.Panel, 0)
-		return errReload
-	case <-errCtx.Done():
+	for {
 		select {
-		case <-h.reloadCh:
-			return errReload
-		default:
-			return fmt.Errorf("panel failed (%w): %w", grp.Wait(), errCtx.Err())
+		case cfg := <-h.configCh:
+			if len(cfg.Panels) == 0 {
+				h.log.Error(`Ignoring configuration with no panels configured`)
+				continue
+			}
+			h.log.Info(`Reloading configuration`)
+			h.checkMonitors(cfg)
+			if err := h.applyConfig(cfg); err != nil {
+				return fmt.Errorf("config reload failed: %w", err)
+			}
+		case stylesheet := <-h.styleCh:
+			h.log.Info(`Reloading stylesheet`)
+			h.applyStyle(stylesheet)
+		case f := <-h.failCh:
+			h.instanceFailed(f)
+		case id := <-h.restartCh:
+			h.restartPanel(id)
+		case <-h.monitorCh:
+			h.log.Info(`Monitors changed, updating panels`)
+			h.applyMonitors()
+		case <-h.quitCh:
+			if err := h.wl.Close(); err != nil {
+				h.log.Error(`Failed to close wl app`, `err`, err)
+			}
+			return nil
 		}
-	case <-h.quitCh:
-		if err := h.wl.Close(); err != nil {
-			h.log.Error(`Failed to close wl app`, `err`, err)
+	}
+}
+
+// updateMonitors refreshes the names of the enabled monitors.
+func (h *host) updateMonitors() error {
+	monitors, err := h.hypr.Monitors()
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(monitors))
+	for _, mon := range monitors {
+		if mon.Disabled {
+			continue
 		}
-		return nil
+		names = append(names, mon.Name)
+	}
+
+	h.mu.Lock()
+	h.monitors = names
+	h.mu.Unlock()
+
+	return nil
+}
+
+// checkMonitors warns about panels configured for monitors that are not currently connected.
+func (h *host) checkMonitors(cfg *configv1.Config) {
+	h.mu.RLock()
+	monitors := h.monitors
+	h.mu.RUnlock()
+	for _, err := range config.ValidateMonitors(cfg, monitors) {
+		h.log.Warn(`Panel monitor unavailable`, `err`, err)
 	}
 }
 
@@ -500,19 +1036,58 @@ func (h *host) Close() {
 
 func (h *host) connectHypr() (hypripc.CancelFunc, error) {
 	var err error
-	h.hypr, err = hypripc.New(h.log)
-	if err != nil {
-		panic(err)
+	if h.replayer != nil {
+		// Queries are answered from the snapshot in the recording, and events
+		// are replaced by the recording.
+		snap, err := hypripc.SnapshotFromValue(h.replayer.snapshot)
+		if err != nil {
+			return nil, err
+		}
+		h.hypr = hypripc.NewOffline(h.log, snap)
+	} else {
+		h.hypr, err = hypripc.New(h.log)
+		if err != nil {
+			return nil, err
+		}
 	}
 	var cancel hypripc.CancelFunc
-	h.hyprEvtCh, cancel = h.hypr.Subscribe()
+	h.caps, err = h.hypr.Capabilities()
+	if err != nil {
+		h.log.Warn(`Failed detecting Hyprland version, assuming all features are available`, `err`, err)
+		h.caps = hypripc.NewCapabilities(nil)
+	} else if !h.caps.Supported() {
+		h.log.Warn(`Unsupported Hyprland version, some features may not work`, `version`, h.caps.Version(), `minimum`, hypripc.MinVersion)
+	}
+	if h.replayer != nil {
+		// Recorded windows do not exist, so can not be captured.
+		h.caps.Set(hypripc.CapabilityToplevelExport, false)
+		h.hyprEvtCh = h.replayer.events()
+		return func() {}, nil
+	}
+	h.caps.Set(hypripc.CapabilityToplevelExport, h.wl != nil && h.wl.ToplevelExport())
+	if h.recorder != nil {
+		snap, err := h.hypr.Snapshot()
+		if err != nil {
+			h.log.Warn(`Failed querying Hyprland state for recording`, `err`, err)
+		} else if err := h.recorder.recordSnapshot(snap); err != nil {
+			h.log.Warn(`Failed recording Hyprland state`, `err`, err)
+		}
+	}
+	h.hyprEvtCh, cancel = h.hypr.Subscribe(eventbus.WithName(`host`), eventbus.WithQueueSize(hyprQueueSize))
 	h.hypr.StartEvents()
 
 	return cancel, nil
 }
 
 func (h *host) connectDBUS() error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.connectDBUSLocked()
+}
+
+func (h *host) connectDBUSLocked() error {
+	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.replayer != nil {
 		return nil
 	}
 
@@ -522,8 +1097,74 @@ func (h *host) connectDBUS() error {
 	return err
 }
 
+func (h *host) closeDBUS() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closeDBUSLocked()
+}
+
+func (h *host) closeDBUSLocked() {
+	if h.dbus == nil {
+		return
+	}
+	if err := h.dbus.Close(); err != nil {
+		h.log.Error(`Failed to close dbus client`, `err`, err)
+	}
+	h.dbus, h.dbusEvtCh = nil, nil
+	h.snapshot.clear(dbusSnapshotKinds...)
+}
+
+// reconnectDBUS replaces the D-Bus client following a configuration change.
+// The lock is held throughout, so that RPCs wait for the new client rather
+// than observing it disconnected.
+func (h *host) reconnectDBUS() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closeDBUSLocked()
+
+	return h.connectDBUSLocked()
+}
+
+// reconfigureDBUS applies cfg to the connected D-Bus client, and discards
+// snapshot state from any features that were restarted. Returns the discarded
+// event kinds.
+//
+// The watch keeps running, since restarted features send events while
+// starting, so the lock is not held.
+func (h *host) reconfigureDBUS(cfg *configv1.Config_DBUS) ([]eventv1.EventKind, error) {
+	client, err := h.dbusClient()
+	if err != nil {
+		return nil, err
+	}
+	mark := h.snapshot.mark()
+	kinds, err := client.Reconfigure(cfg)
+	h.snapshot.clearBefore(mark, kinds...)
+
+	return kinds, err
+}
+
+// dbusClient returns the D-Bus client, or errDisabled if it is not connected.
+// RPCs may be called concurrently with reconfiguration, so the client is only
+// accessed under the lock.
+func (h *host) dbusClient() (*dbus.Client, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.dbus == nil {
+		return nil, errDisabled
+	}
+
+	return h.dbus, nil
+}
+
 func (h *host) connectAudio() error {
-	if h.cfg.Audio == nil || !h.cfg.Audio.Enabled {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.connectAudioLocked()
+}
+
+func (h *host) connectAudioLocked() error {
+	if h.cfg.Audio == nil || !h.cfg.Audio.Enabled || h.replayer != nil {
 		return nil
 	}
 
@@ -533,6 +1174,45 @@ func (h *host) connectAudio() error {
 	return err
 }
 
+func (h *host) closeAudio() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closeAudioLocked()
+}
+
+func (h *host) closeAudioLocked() {
+	if h.audio == nil {
+		return
+	}
+	if err := h.audio.Close(); err != nil {
+		h.log.Error(`Failed to close audio client`, `err`, err)
+	}
+	h.audio, h.audioEvtCh = nil, nil
+	h.snapshot.clear(audioSnapshotKinds...)
+}
+
+// reconnectAudio replaces the audio client following a configuration change.
+// The lock is held throughout, so that RPCs wait for the new client rather
+// than observing it disconnected.
+func (h *host) reconnectAudio() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closeAudioLocked()
+
+	return h.connectAudioLocked()
+}
+
+// audioClient returns the audio client, or errDisabled if it is not connected.
+func (h *host) audioClient() (*audio.Client, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.audio == nil {
+		return nil, errDisabled
+	}
+
+	return h.audio, nil
+}
+
 func newHost(cfg *configv1.Config, stylesheet []byte, log hclog.Logger) (*host, error) {
 	var err error
 	wlApp, err := wl.NewApp(log)
@@ -541,14 +1221,22 @@ func newHost(cfg *configv1.Config, stylesheet []byte, log hclog.Logger) (*host,
 	}
 
 	h := &host{
-		cfg:         cfg,
-		stylesheet:  stylesheet,
-		log:         log,
-		pluginLog:   log.Named(`plugin`),
-		wl:          wlApp,
-		reloadCh:    make(chan struct{}),
-		stopWatchCh: make(chan struct{}),
-		quitCh:      make(chan struct{}),
+		cfg:        cfg,
+		stylesheet: stylesheet,
+		log:        log,
+		pluginLog:  log.Named(`plugin`),
+		wl:         wlApp,
+		panels:     make(map[string]*panelInstance),
+		bus:        eventbus.New(),
+		snapshot:   newSnapshot(),
+		history:    make(map[string]*panelHistory),
+		hidden:     make(map[string]struct{}),
+		configCh:   make(chan *configv1.Config),
+		styleCh:    make(chan []byte),
+		failCh:     make(chan panelFailure),
+		restartCh:  make(chan string),
+		monitorCh:  make(chan struct{}, 1),
+		quitCh:     make(chan struct{}),
 	}
 
 	return h, nil
@@ -15,11 +15,13 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-plugin"
 	"github.com/pdf/hyprpanel/config"
+	"github.com/pdf/hyprpanel/internal/control"
+	"github.com/pdf/hyprpanel/internal/hypripc"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
 	"github.com/pdf/hyprpanel/style"
 	"github.com/peterbourgon/ff/v4"
 	"github.com/peterbourgon/ff/v4/ffhelp"
 	"golang.org/x/sys/unix"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
 const (
@@ -53,6 +55,151 @@ func reapChildren(log hclog.Logger) {
 	}
 }
 
+// migrate upgrades cfg to the current configuration version, logging each
+// change, and optionally writing the result back to the configuration file.
+func migrate(cfg *configv1.Config, configFile string, sources []string, write bool, log hclog.Logger) error {
+	changes, err := config.Migrate(cfg)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	for _, change := range changes {
+		log.Info(`Migrated configuration`, `from`, change.From, `to`, change.To, `change`, change.Description)
+	}
+
+	if !write {
+		log.Warn(`Configuration was migrated in memory, run with --migrate-config to update the configuration file`, `file`, configFile)
+		return nil
+	}
+	if len(sources) != 1 || sources[0] != configFile {
+		log.Warn(`Not writing migrated configuration, it is assembled from multiple files`, `sources`, sources)
+		return nil
+	}
+
+	format, err := config.FormatFromPath(configFile)
+	if err != nil {
+		return err
+	}
+	b, err := config.Marshal(cfg, format)
+	if err != nil {
+		return err
+	}
+	orig, err := os.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+	backup := configFile + `.bak`
+	if err := os.WriteFile(backup, orig, 0o644); err != nil {
+		return fmt.Errorf("failed writing configuration backup: %w", err)
+	}
+	if err := os.WriteFile(configFile, b, 0o644); err != nil {
+		return err
+	}
+	log.Info(`Wrote migrated configuration`, `file`, configFile, `backup`, backup)
+
+	return nil
+}
+
+// convertConfigFile writes the configuration file at src to dst, in the format
+// determined by the dst file extension. Only src is converted, includes and
+// overlays are not merged into the result.
+func convertConfigFile(src, dst string) error {
+	from, err := config.FormatFromPath(src)
+	if err != nil {
+		return err
+	}
+	to, err := config.FormatFromPath(dst)
+	if err != nil {
+		return err
+	}
+	in, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	b, err := config.Convert(in, from, to)
+	if err != nil {
+		return fmt.Errorf("%s: %w", src, err)
+	}
+	if _, err := os.Stat(dst); err == nil {
+		return fmt.Errorf("refusing to overwrite existing file: %s", dst)
+	}
+
+	return os.WriteFile(dst, b, 0o644)
+}
+
+// selectInstance resolves the Hyprland instance from the flag or configuration,
+// and exports it to the environment so that the host and panels connect to
+// the same instance. An explicitly selected instance also determines the
+// Wayland display, as it may be nested in another session.
+func selectInstance(flagInstance string, cfg *configv1.Config, log hclog.Logger) error {
+	sig := flagInstance
+	if sig == `` {
+		sig = cfg.HyprlandInstance
+	}
+	inst, err := hypripc.SelectInstance(sig)
+	if err != nil {
+		return err
+	}
+	if err := os.Setenv(`HYPRLAND_INSTANCE_SIGNATURE`, inst.Instance); err != nil {
+		return err
+	}
+	if inst.WlSocket != `` && (sig != `` || os.Getenv(`WAYLAND_DISPLAY`) == ``) {
+		if err := os.Setenv(`WAYLAND_DISPLAY`, inst.WlSocket); err != nil {
+			return err
+		}
+	}
+	log.Debug(`Selected Hyprland instance`, `instance`, inst.Instance, `display`, os.Getenv(`WAYLAND_DISPLAY`))
+
+	return nil
+}
+
+// checkConfigFile validates the configuration file at path, printing any
+// problems found, and returns the process exit code.
+func checkConfigFile(path, instance string, log hclog.Logger) int {
+	cfg, err := config.Load(path)
+	if err != nil {
+		fmt.Printf("%s: %v\n", path, err)
+		return 1
+	}
+
+	changes, err := config.Migrate(cfg)
+	if err != nil {
+		fmt.Printf("%s: %v\n", path, err)
+		return 1
+	}
+	for _, change := range changes {
+		fmt.Printf("%s: pending migration %s\n", path, change)
+	}
+
+	errs := config.Validate(cfg)
+	if err := selectInstance(instance, cfg, log); err != nil {
+		fmt.Printf("%s: hyprland unavailable, monitor names not checked: %v\n", path, err)
+	} else if hypr, err := hypripc.New(log); err == nil {
+		defer hypr.Close()
+		if monitors, err := hypr.Monitors(); err == nil {
+			names := make([]string, len(monitors))
+			for i, mon := range monitors {
+				names[i] = mon.Name
+			}
+			errs = append(errs, config.ValidateMonitors(cfg, names)...)
+		}
+	} else {
+		fmt.Printf("%s: hyprland unavailable, monitor names not checked\n", path)
+	}
+
+	if len(errs) == 0 {
+		fmt.Printf("%s: configuration OK\n", path)
+		return 0
+	}
+	for _, err := range errs {
+		fmt.Printf("%s: %v\n", path, err)
+	}
+
+	return 1
+}
+
 func sigHandler(log hclog.Logger, h *host) {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGUSR1, syscall.SIGINT, syscall.SIGCHLD)
@@ -80,10 +227,21 @@ func main() {
 	} else {
 		configPath = filepath.Join(xdgConfigPath, `hyprpanel`)
 	}
-	configFileDefault := filepath.Join(configPath, `config.json`)
-	configFile := fs.String('c', `config`, configFileDefault, `Path to configuration file`)
+	configFileDefault := config.Find(configPath)
+	configFile := fs.String('c', `config`, configFileDefault, `Path to configuration file (.json, .jsonc, .yaml, .yml or .toml)`)
 	styleFileDefault := filepath.Join(configPath, `style.css`)
 	styleFile := fs.String('s', `style`, styleFileDefault, `Path to stylesheet`)
+	controlSocketDefault, _ := control.SocketPath()
+	controlSocket := fs.StringLong(`control-socket`, controlSocketDefault, `Path to control socket, empty to disable`)
+	checkConfig := fs.BoolLong(`check-config`, `Validate the configuration file and exit`)
+	migrateConfig := fs.BoolLong(`migrate-config`, `Write automatically migrated configuration back to the configuration file`)
+	printConfig := fs.BoolLong(`print-config`, `Print the configuration file merged over the defaults, annotated with the origin of each value, and exit`)
+	printSchema := fs.BoolLong(`print-schema`, `Print the configuration JSON Schema and exit`)
+	instance := fs.StringLong(`instance`, ``, `Hyprland instance signature to connect to, overrides the configuration`)
+	record := fs.StringLong(`record`, ``, `Record events broadcast to panels to the specified file, replacing its contents`)
+	replay := fs.StringLong(`replay`, ``, `Replay events from a recording instead of Hyprland, D-Bus and audio events`)
+	replaySpeed := fs.Float64Long(`replay-speed`, 1, `Replay speed multiplier, 0 replays without delay`)
+	convertConfig := fs.StringLong(`convert-config`, ``, `Convert the configuration file to the format of the specified output path and exit`)
 	version := fs.BoolLong(`version`, `Display the application version`)
 
 	log := hclog.New(&hclog.LoggerOptions{
@@ -116,12 +274,48 @@ func main() {
 		os.Exit(0)
 	}
 
-	cfg, err := config.Load(*configFile)
+	if *printSchema {
+		if _, err := os.Stdout.Write(config.Schema()); err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if *printConfig {
+		b, err := config.PrintEffective(*configFile)
+		if err != nil {
+			log.Error(`Failed loading configuration file`, `file`, *configFile, `err`, err)
+			os.Exit(1)
+		}
+		if _, err := os.Stdout.Write(b); err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if *checkConfig {
+		os.Exit(checkConfigFile(*configFile, *instance, log))
+	}
+
+	if *convertConfig != `` {
+		if err := convertConfigFile(*configFile, *convertConfig); err != nil {
+			log.Error(`Failed converting configuration file`, `src`, *configFile, `dst`, *convertConfig, `err`, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if abs, err := filepath.Abs(*configFile); err == nil {
+		*configFile = abs
+	}
+
+	cfg, sources, err := config.LoadSources(*configFile)
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
 			log.Error(`Failed loading configuration file`, `file`, *configFile, `err`, err)
 			os.Exit(1)
 		}
+		sources = &config.Sources{Files: []string{*configFile}}
 		log.Warn(`Failed loading configuration file, creating with defaults`, `file`, *configFile)
 		cfg, err = config.Default()
 		if err != nil {
@@ -133,13 +327,12 @@ func main() {
 			os.Exit(1)
 		}
 
-		marshal := protojson.MarshalOptions{
-			Multiline:       true,
-			Indent:          "\t",
-			EmitUnpopulated: true,
-			UseProtoNames:   true,
+		format, err := config.FormatFromPath(*configFile)
+		if err != nil {
+			log.Error(`Failed encoding default configuration file`, `err`, err)
+			os.Exit(1)
 		}
-		b, err := marshal.Marshal(cfg)
+		b, err := config.Marshal(cfg, format)
 		if err != nil {
 			log.Error(`Failed encoding default configuration file`, `err`, err)
 			os.Exit(1)
@@ -152,6 +345,17 @@ func main() {
 	}
 
 	log.SetLevel(hclog.Level(cfg.LogLevel))
+	if err := migrate(cfg, *configFile, sources.Files, *migrateConfig, log); err != nil {
+		log.Error(`Failed migrating configuration`, `file`, *configFile, `err`, err)
+		os.Exit(1)
+	}
+	for _, err := range config.Validate(cfg) {
+		log.Warn(`Invalid configuration`, `err`, err)
+	}
+	if err := selectInstance(*instance, cfg, log); err != nil {
+		log.Error(`Failed selecting Hyprland instance`, `err`, err)
+		os.Exit(1)
+	}
 
 	stylesheet, err := style.Load(*styleFile)
 	if err != nil {
@@ -169,6 +373,28 @@ func main() {
 	}
 	go sigHandler(log, h)
 
+	if *record != `` {
+		rec, err := newRecorder(*record)
+		if err != nil {
+			log.Error(`Failed opening recording file`, `file`, *record, `err`, err)
+			os.Exit(1)
+		}
+		defer func() {
+			if err := rec.Close(); err != nil {
+				log.Error(`Failed closing recording file`, `file`, *record, `err`, err)
+			}
+		}()
+		h.recorder = rec
+	}
+	if *replay != `` {
+		h.replayer, err = newReplayer(*replay, *replaySpeed, log.Named(`replay`))
+		if err != nil {
+			log.Error(`Failed opening replay file`, `file`, *replay, `err`, err)
+			os.Exit(1)
+		}
+		log.Info(`Replaying events, D-Bus and audio are disabled`, `file`, *replay, `speed`, *replaySpeed)
+	}
+
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
 		log.Error(`Failed initializaing filesystem watcher`, `err`, err)
@@ -180,6 +406,43 @@ func main() {
 		}
 	}()
 
+	cw := newConfigWatcher(watcher, *configFile)
+
+	reloadConfig := func() error {
+		cfg, sources, err := config.LoadSources(*configFile)
+		if os.IsNotExist(err) {
+			sources = &config.Sources{Files: []string{*configFile}}
+			cfg, err = config.Default()
+		}
+		if err != nil {
+			return err
+		}
+		if err := migrate(cfg, *configFile, sources.Files, *migrateConfig, log); err != nil {
+			return fmt.Errorf("configuration migration failed: %w", err)
+		}
+		if errs := config.Validate(cfg); len(errs) > 0 {
+			for _, err := range errs {
+				log.Error(`Invalid configuration`, `err`, err)
+			}
+			return fmt.Errorf("invalid configuration, keeping current config: %w", errors.Join(errs...))
+		}
+		if err := cw.setSources(sources); err != nil {
+			log.Warn(`Failed watching configuration sources`, `err`, err)
+		}
+		log.SetLevel(hclog.Level(cfg.LogLevel))
+		h.updateConfig(cfg)
+		return nil
+	}
+
+	if *controlSocket != `` {
+		ctl, err := newControlServer(h, *controlSocket, reloadConfig, log.Named(`control`))
+		if err != nil {
+			log.Error(`Failed initializing control socket`, `path`, *controlSocket, `err`, err)
+			os.Exit(1)
+		}
+		defer ctl.Close()
+	}
+
 	go func() {
 		for {
 			select {
@@ -187,19 +450,13 @@ func main() {
 				if !evt.Has(fsnotify.Write) && !evt.Has(fsnotify.Create) && !evt.Has(fsnotify.Remove) {
 					continue
 				}
-				switch evt.Name {
-				case *configFile:
-					cfg, err := config.Load(*configFile)
-					if os.IsNotExist(err) {
-						cfg, err = config.Default()
-					}
-					if err != nil {
+				switch {
+				case cw.isSource(evt.Name):
+					if err := reloadConfig(); err != nil {
 						log.Error(`Failed reloading config`, `err`, err)
 						continue
 					}
-					log.SetLevel(hclog.Level(cfg.LogLevel))
-					h.updateConfig(cfg)
-				case *styleFile:
+				case evt.Name == *styleFile:
 					stylesheet, err := style.Load(*styleFile)
 					if os.IsNotExist(err) {
 						stylesheet = style.Default
@@ -218,17 +475,14 @@ func main() {
 		}
 	}()
 
-	configDir := filepath.Dir(*configFile)
-	styleDir := filepath.Dir(*styleFile)
-	if err := watcher.Add(configDir); err != nil {
-		log.Error(`Failed adding filesystem watch path`, `path`, configDir, `err`, err)
+	if err := cw.setSources(sources); err != nil {
+		log.Error(`Failed adding filesystem watch path`, `err`, err)
 		os.Exit(1)
 	}
-	if configDir != styleDir {
-		if err := watcher.Add(styleDir); err != nil {
-			log.Error(`Failed adding filesystem watch path`, `path`, styleDir, `err`, err)
-			os.Exit(1)
-		}
+	styleDir := filepath.Dir(*styleFile)
+	if err := watcher.Add(styleDir); err != nil {
+		log.Error(`Failed adding filesystem watch path`, `path`, styleDir, `err`, err)
+		os.Exit(1)
 	}
 	defer plugin.CleanupClients()
 
@@ -236,7 +490,7 @@ func main() {
 	timer := time.NewTimer(crashTimeout)
 	for {
 		err := h.run()
-		if err != nil && err != errReload {
+		if err != nil {
 			count += 1
 			log.Error(`Clients failed`, `err`, err)
 			select {
@@ -251,10 +505,8 @@ func main() {
 			}
 			time.Sleep(crashRetry)
 			continue
-		} else if err != nil && err == errReload {
-			continue
 		}
 
-		os.Exit(0)
+		return
 	}
 }
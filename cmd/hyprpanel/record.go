@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pdf/hyprpanel/internal/hypripc"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxRecordSize is the maximum length of a single recorded event line.
+const maxRecordSize = 16 * 1024 * 1024
+
+// recorder writes broadcast events to a file as JSON lines, one
+// eventv1.RecordedEvent per line.
+type recorder struct {
+	f  *os.File
+	mu sync.Mutex
+}
+
+// record appends evt to the recording, timestamped with the current time.
+func (r *recorder) record(evt *eventv1.Event) error {
+	return r.write(&eventv1.RecordedEvent{
+		Time:  timestamppb.Now(),
+		Event: evt,
+	})
+}
+
+// recordSnapshot appends the Hyprland state to the recording, so that it may
+// be replayed without Hyprland.
+func (r *recorder) recordSnapshot(snap *hypripc.Snapshot) error {
+	v, err := snap.Value()
+	if err != nil {
+		return fmt.Errorf("failed encoding snapshot: %w", err)
+	}
+
+	return r.write(&eventv1.RecordedEvent{
+		Time:         timestamppb.Now(),
+		HyprSnapshot: v,
+	})
+}
+
+func (r *recorder) write(rec *eventv1.RecordedEvent) error {
+	b, err := protojson.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed encoding event: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := r.f.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("failed writing event: %w", err)
+	}
+
+	return nil
+}
+
+func (r *recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.f.Close()
+}
+
+func newRecorder(path string) (*recorder, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recorder{f: f}, nil
+}
+
+// replayer reads a recording made by recorder, and emits the events with the
+// original delay between them, divided by speed. A speed of zero emits events
+// without delay. The recording must start with a Hyprland snapshot, which is
+// used to answer queries in place of Hyprland.
+type replayer struct {
+	path     string
+	speed    float64
+	log      hclog.Logger
+	snapshot *eventv1.HyprSnapshotValue
+	evtCh    chan *eventv1.Event
+}
+
+// events returns the channel on which replayed events are emitted.
+func (r *replayer) events() <-chan *eventv1.Event {
+	return r.evtCh
+}
+
+// run replays the recording from the start, returning once all events have
+// been emitted, or stopCh is closed.
+func (r *replayer) run(stopCh <-chan struct{}) error {
+	f, err := os.Open(r.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxRecordSize)
+	var (
+		prev  time.Time
+		count int
+		line  int
+	)
+	for scanner.Scan() {
+		line++
+		b := bytes.TrimSpace(scanner.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+		rec := &eventv1.RecordedEvent{}
+		if err := protojson.Unmarshal(b, rec); err != nil {
+			return fmt.Errorf("invalid event on line %d: %w", line, err)
+		}
+		if rec.Event == nil {
+			if rec.HyprSnapshot != nil {
+				// Snapshots recorded on reconnection are superseded by events.
+				continue
+			}
+			return fmt.Errorf("missing event on line %d", line)
+		}
+
+		ts := rec.Time.AsTime()
+		if !prev.IsZero() && r.speed > 0 {
+			if delay := time.Duration(float64(ts.Sub(prev)) / r.speed); delay > 0 {
+				select {
+				case <-time.After(delay):
+				case <-stopCh:
+					return nil
+				}
+			}
+		}
+		prev = ts
+
+		select {
+		case r.evtCh <- rec.Event:
+			count++
+		case <-stopCh:
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	r.log.Info(`Replay complete`, `file`, r.path, `events`, count)
+
+	return nil
+}
+
+func newReplayer(path string, speed float64, log hclog.Logger) (*replayer, error) {
+	if speed < 0 {
+		return nil, fmt.Errorf("invalid replay speed: %v", speed)
+	}
+	snap, err := readSnapshot(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replayer{
+		path:     path,
+		speed:    speed,
+		log:      log,
+		snapshot: snap,
+		evtCh:    make(chan *eventv1.Event),
+	}, nil
+}
+
+// readSnapshot returns the Hyprland snapshot from the first line of the
+// recording at path.
+func readSnapshot(path string) (*eventv1.HyprSnapshotValue, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxRecordSize)
+	for scanner.Scan() {
+		b := bytes.TrimSpace(scanner.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+		rec := &eventv1.RecordedEvent{}
+		if err := protojson.Unmarshal(b, rec); err != nil {
+			return nil, fmt.Errorf("invalid recording: %w", err)
+		}
+		if rec.HyprSnapshot == nil {
+			break
+		}
+		if _, err := hypripc.SnapshotFromValue(rec.HyprSnapshot); err != nil {
+			return nil, err
+		}
+		return rec.HyprSnapshot, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, errors.New(`recording does not start with a Hyprland snapshot`)
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	audioSnapshotKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_AUDIO_SINK_CHANGE,
+		eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_CHANGE,
+	}
+	sniUpdateKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATETITLE,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATETOOLTIP,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATEICON,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATESTATUS,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATEMENU,
+	}
+	dbusSnapshotKinds = append([]eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE,
+		eventv1.EventKind_EVENT_KIND_DBUS_BRIGHTNESS_CHANGE,
+		eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER,
+	}, sniUpdateKinds...)
+)
+
+type snapshotKey struct {
+	kind eventv1.EventKind
+	id   string
+}
+
+type snapshotEntry struct {
+	evt *eventv1.Event
+	seq uint64
+}
+
+// snapshot holds the latest event for each audio device, power device,
+// brightness device and StatusNotifierItem, so that panels started after the
+// event was broadcast can be brought up to date.
+type snapshot struct {
+	entries map[snapshotKey]snapshotEntry
+	seq     uint64
+	mu      sync.Mutex
+}
+
+// record stores evt if it describes the state of an entity, replacing any
+// previous event of the same kind for the entity.
+func (s *snapshot) record(evt *eventv1.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch evt.Kind {
+	case eventv1.EventKind_EVENT_KIND_DBUS_UNREGISTERSTATUSNOTIFIER:
+		busName, err := eventv1.DataString(evt.Data)
+		if err != nil {
+			return
+		}
+		s.deleteEntity(busName)
+		return
+	case eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER:
+		data := &eventv1.StatusNotifierValue{}
+		if !unmarshalEvent(evt, data) {
+			return
+		}
+		// Registration carries the full item state, superseding updates.
+		s.deleteEntity(data.BusName)
+		s.store(evt, data.BusName)
+		return
+	}
+
+	id, ok := snapshotEntityID(evt)
+	if !ok {
+		return
+	}
+	if slices.Contains(sniUpdateKinds, evt.Kind) {
+		// Updates are only meaningful for registered items.
+		if _, ok := s.entries[snapshotKey{kind: eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER, id: id}]; !ok {
+			return
+		}
+	}
+	s.store(evt, id)
+}
+
+// events returns the stored events in the order they were recorded, restricted
+// to the specified kinds if filter is set.
+func (s *snapshot) events(filter bool, kinds ...eventv1.EventKind) []*eventv1.Event {
+	s.mu.Lock()
+	entries := make([]snapshotEntry, 0, len(s.entries))
+	for key, entry := range s.entries {
+		if filter && !slices.Contains(kinds, key.kind) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	s.mu.Unlock()
+
+	slices.SortFunc(entries, func(a, b snapshotEntry) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+	events := make([]*eventv1.Event, len(entries))
+	for i, entry := range entries {
+		events[i] = entry.evt
+	}
+
+	return events
+}
+
+// clear discards stored events of the specified kinds, for sources that are
+// no longer available.
+func (s *snapshot) clear(kinds ...eventv1.EventKind) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.entries {
+		if slices.Contains(kinds, key.kind) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// mark returns the sequence of the most recently stored event, for use with
+// clearBefore.
+func (s *snapshot) mark() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.seq
+}
+
+// clearBefore discards stored events of the specified kinds that were stored
+// no later than mark, for sources that were restarted since.
+func (s *snapshot) clearBefore(mark uint64, kinds ...eventv1.EventKind) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, entry := range s.entries {
+		if entry.seq <= mark && slices.Contains(kinds, key.kind) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+func (s *snapshot) store(evt *eventv1.Event, id string) {
+	s.seq++
+	s.entries[snapshotKey{kind: evt.Kind, id: id}] = snapshotEntry{evt: evt, seq: s.seq}
+}
+
+func (s *snapshot) deleteEntity(id string) {
+	for key := range s.entries {
+		if key.id == id {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// snapshotEntityID returns the ID of the entity described by evt, if the
+// event kind is stored in the snapshot.
+func snapshotEntityID(evt *eventv1.Event) (string, bool) {
+	switch evt.Kind {
+	case eventv1.EventKind_EVENT_KIND_AUDIO_SINK_CHANGE:
+		data := &eventv1.AudioSinkChangeValue{}
+		ok := unmarshalEvent(evt, data)
+		return data.Id, ok
+	case eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_CHANGE:
+		data := &eventv1.AudioSourceChangeValue{}
+		ok := unmarshalEvent(evt, data)
+		return data.Id, ok
+	case eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE:
+		data := &eventv1.PowerChangeValue{}
+		ok := unmarshalEvent(evt, data)
+		return data.Id, ok
+	case eventv1.EventKind_EVENT_KIND_DBUS_BRIGHTNESS_CHANGE:
+		data := &eventv1.BrightnessChangeValue{}
+		ok := unmarshalEvent(evt, data)
+		return data.Id, ok
+	case eventv1.EventKind_EVENT_KIND_DBUS_UPDATETITLE:
+		data := &eventv1.UpdateTitleValue{}
+		ok := unmarshalEvent(evt, data)
+		return data.BusName, ok
+	case eventv1.EventKind_EVENT_KIND_DBUS_UPDATETOOLTIP:
+		data := &eventv1.UpdateTooltipValue{}
+		ok := unmarshalEvent(evt, data)
+		return data.BusName, ok
+	case eventv1.EventKind_EVENT_KIND_DBUS_UPDATEICON:
+		data := &eventv1.UpdateIconValue{}
+		ok := unmarshalEvent(evt, data)
+		return data.BusName, ok
+	case eventv1.EventKind_EVENT_KIND_DBUS_UPDATEMENU:
+		data := &eventv1.UpdateMenuValue{}
+		ok := unmarshalEvent(evt, data)
+		return data.BusName, ok
+	case eventv1.EventKind_EVENT_KIND_DBUS_UPDATESTATUS:
+		data := &eventv1.UpdateStatusValue{}
+		ok := unmarshalEvent(evt, data)
+		return data.BusName, ok
+	default:
+		return ``, false
+	}
+}
+
+func unmarshalEvent(evt *eventv1.Event, msg proto.Message) bool {
+	return evt.Data != nil && evt.Data.MessageIs(msg) && evt.Data.UnmarshalTo(msg) == nil
+}
+
+func newSnapshot() *snapshot {
+	return &snapshot{
+		entries: make(map[snapshotKey]snapshotEntry),
+	}
+}
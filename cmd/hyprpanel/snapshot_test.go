@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func newPowerEvent(t *testing.T, id string) *eventv1.Event {
+	t.Helper()
+	data, err := anypb.New(&eventv1.PowerChangeValue{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &eventv1.Event{Kind: eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE, Data: data}
+}
+
+func TestSnapshotClearBefore(t *testing.T) {
+	s := newSnapshot()
+	s.record(newPowerEvent(t, `BAT0`))
+	s.record(newPowerEvent(t, `BAT1`))
+	mark := s.mark()
+	// Events from the restarted feature are kept.
+	s.record(newPowerEvent(t, `BAT1`))
+
+	s.clearBefore(mark, eventv1.EventKind_EVENT_KIND_DBUS_BRIGHTNESS_CHANGE)
+	if got := len(s.events(false)); got != 2 {
+		t.Fatalf("cleared unrelated kind, got %d events, want 2", got)
+	}
+
+	s.clearBefore(mark, eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE)
+	events := s.events(false)
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+	data := &eventv1.PowerChangeValue{}
+	if !unmarshalEvent(events[0], data) || data.Id != `BAT1` {
+		t.Errorf("got %v, want BAT1", events[0])
+	}
+}
+
+func TestPanelInstanceConsumes(t *testing.T) {
+	power := eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE
+	audio := eventv1.EventKind_EVENT_KIND_AUDIO_SINK_CHANGE
+
+	tests := []struct {
+		name  string
+		inst  *panelInstance
+		kinds []eventv1.EventKind
+		want  bool
+	}{
+		{name: `unfiltered`, inst: &panelInstance{}, kinds: []eventv1.EventKind{power}, want: true},
+		{name: `unfiltered without kinds`, inst: &panelInstance{}},
+		{name: `filtered match`, inst: &panelInstance{filter: true, kinds: []eventv1.EventKind{audio, power}}, kinds: []eventv1.EventKind{power}, want: true},
+		{name: `filtered miss`, inst: &panelInstance{filter: true, kinds: []eventv1.EventKind{audio}}, kinds: []eventv1.EventKind{power}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.inst.consumes(tt.kinds...); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"time"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+)
+
+const (
+	panelRestartDelay    = 200 * time.Millisecond
+	panelMaxRestartDelay = 30 * time.Second
+	panelFailureWindow   = 60 * time.Second
+	panelMaxFailures     = 5
+)
+
+// panelFailure reports the unexpected exit of a panel instance.
+type panelFailure struct {
+	inst *panelInstance
+	err  error
+}
+
+// panelHistory records the crash history of a panel across restarts.
+type panelHistory struct {
+	failures  []time.Time
+	lastErr   error
+	restarts  uint32
+	pending   bool
+	abandoned bool
+}
+
+// supervisorConfig holds the effective supervision parameters.
+type supervisorConfig struct {
+	restartDelay    time.Duration
+	maxRestartDelay time.Duration
+	failureWindow   time.Duration
+	maxFailures     int
+}
+
+func newSupervisorConfig(cfg *configv1.Config_Supervisor) supervisorConfig {
+	s := supervisorConfig{
+		restartDelay:    panelRestartDelay,
+		maxRestartDelay: panelMaxRestartDelay,
+		failureWindow:   panelFailureWindow,
+		maxFailures:     panelMaxFailures,
+	}
+	if cfg == nil {
+		return s
+	}
+
+	if d := cfg.RestartDelay.AsDuration(); d > 0 {
+		s.restartDelay = d
+	}
+	if d := cfg.MaxRestartDelay.AsDuration(); d > 0 {
+		s.maxRestartDelay = d
+	}
+	if d := cfg.FailureWindow.AsDuration(); d > 0 {
+		s.failureWindow = d
+	}
+	if cfg.MaxFailures != nil {
+		s.maxFailures = int(cfg.GetMaxFailures())
+	}
+
+	return s
+}
+
+// backoff returns the restart delay after the specified number of consecutive failures.
+func (s supervisorConfig) backoff(failures int) time.Duration {
+	delay := s.restartDelay
+	for i := 1; i < failures && delay < s.maxRestartDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, s.maxRestartDelay)
+}
+
+// launchPanel starts a panel, treating initialization errors as panel failures.
+func (h *host) launchPanel(cfg *configv1.Panel) {
+	if err := h.startPanel(cfg); err != nil {
+		h.panelFailed(cfg.Id, err)
+	}
+}
+
+// instanceFailed handles a failure reported by a panel instance, ignoring
+// reports from instances that have since been replaced.
+func (h *host) instanceFailed(f panelFailure) {
+	id := f.inst.cfg.Id
+	h.mu.RLock()
+	current := h.panels[id] == f.inst
+	h.mu.RUnlock()
+	if !current {
+		return
+	}
+
+	h.panelFailed(id, f.err)
+}
+
+// panelFailed records a panel failure, and schedules a restart unless the
+// panel has exhausted its failure budget.
+func (h *host) panelFailed(id string, err error) {
+	h.stopPanel(id)
+
+	sup := newSupervisorConfig(h.cfg.Supervisor)
+	now := time.Now()
+
+	h.mu.Lock()
+	hist, ok := h.history[id]
+	if !ok {
+		hist = &panelHistory{}
+		h.history[id] = hist
+	}
+	hist.lastErr = err
+	cutoff := now.Add(-sup.failureWindow)
+	recent := hist.failures[:0]
+	for _, t := range hist.failures {
+		if t.After(cutoff) {
+			recent = append(recent, t)
+		}
+	}
+	hist.failures = append(recent, now)
+	failures := len(hist.failures)
+	if sup.maxFailures > 0 && failures >= sup.maxFailures {
+		hist.abandoned = true
+		h.mu.Unlock()
+		h.log.Error(`Panel failed too many times, giving up`, `id`, id, `failures`, failures, `window`, sup.failureWindow, `err`, err)
+		return
+	}
+	hist.pending = true
+	h.mu.Unlock()
+
+	delay := sup.backoff(failures)
+	h.log.Warn(`Panel failed, restarting`, `id`, id, `failures`, failures, `delay`, delay, `err`, err)
+	time.AfterFunc(delay, func() {
+		select {
+		case h.restartCh <- id:
+		case <-h.quitCh:
+		}
+	})
+}
+
+// restartPanel restarts a failed panel if a restart is still pending.
+func (h *host) restartPanel(id string) {
+	h.mu.Lock()
+	hist, ok := h.history[id]
+	if !ok || !hist.pending {
+		h.mu.Unlock()
+		return
+	}
+	hist.pending = false
+	hist.restarts++
+	_, running := h.panels[id]
+	var cfg *configv1.Panel
+	for _, panelCfg := range h.panelCfgs {
+		if panelCfg.Id == id {
+			cfg = panelCfg
+			break
+		}
+	}
+	h.mu.Unlock()
+
+	if running || cfg == nil {
+		return
+	}
+
+	h.log.Info(`Restarting panel`, `id`, id)
+	h.launchPanel(cfg)
+}
+
+// resetHistory discards the crash history for a panel, allowing an abandoned
+// panel to be started again.
+func (h *host) resetHistory(id string) {
+	h.mu.Lock()
+	delete(h.history, id)
+	h.mu.Unlock()
+}
+
+// abandoned reports whether the panel has exhausted its failure budget, or is awaiting restart.
+func (h *host) abandoned(id string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	hist, ok := h.history[id]
+
+	return ok && (hist.abandoned || hist.pending)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pdf/hyprpanel/config"
+)
+
+// configWatcher tracks the files and include patterns that make up the active
+// configuration, and watches their directories for changes.
+type configWatcher struct {
+	watcher    *fsnotify.Watcher
+	overlayDir string
+	files      map[string]struct{}
+	includes   []string
+	mu         sync.RWMutex
+}
+
+// setSources replaces the configuration sources, and watches their
+// directories. Directories are re-added on every call, as watches are dropped
+// when a directory is removed.
+func (w *configWatcher) setSources(sources *config.Sources) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.files = make(map[string]struct{}, len(sources.Files))
+	w.includes = sources.Includes
+	dirs := make([]string, 0, len(sources.Files)+len(sources.Includes)+1)
+	for _, file := range sources.Files {
+		w.files[file] = struct{}{}
+		dirs = append(dirs, filepath.Dir(file))
+	}
+	for _, include := range sources.Includes {
+		dirs = append(dirs, filepath.Dir(include))
+	}
+	dirs = append(dirs, w.overlayDir)
+
+	for _, dir := range dirs {
+		// Missing directories are picked up from their parent when created.
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			continue
+		}
+		if err := w.watcher.Add(dir); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isSource reports whether a change to the named file affects the configuration.
+func (w *configWatcher) isSource(name string) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if _, ok := w.files[name]; ok {
+		return true
+	}
+	if name == w.overlayDir {
+		return true
+	}
+	if filepath.Dir(name) == w.overlayDir {
+		_, err := config.FormatFromPath(name)
+		return err == nil
+	}
+	for _, include := range w.includes {
+		if name == filepath.Dir(include) {
+			return true
+		}
+		if ok, _ := filepath.Match(include, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newConfigWatcher(watcher *fsnotify.Watcher, configFile string) *configWatcher {
+	return &configWatcher{
+		watcher:    watcher,
+		overlayDir: config.OverlayDir(configFile),
+		files:      make(map[string]struct{}),
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pdf/hyprpanel/config"
+)
+
+func TestConfigWatcherIsSource(t *testing.T) {
+	dir := t.TempDir()
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+
+	configFile := filepath.Join(dir, `config.yaml`)
+	w := newConfigWatcher(watcher, configFile)
+	if err := w.setSources(&config.Sources{
+		Files:    []string{configFile},
+		Includes: []string{filepath.Join(dir, `conf`, `*.yaml`)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: `config.yaml`, want: true},
+		{name: `other.yaml`, want: false},
+		{name: `config.d`, want: true},
+		{name: `config.d/laptop.yaml`, want: true},
+		{name: `config.d/notes.txt`, want: false},
+		{name: `conf`, want: true},
+		{name: `conf/new.yaml`, want: true},
+		{name: `conf/new.toml`, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := w.isSource(filepath.Join(dir, tt.name)); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
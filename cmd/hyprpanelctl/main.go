@@ -0,0 +1,299 @@
+// Package main provides the hyprpanelctl binary for controlling a running hyprpanel host
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pdf/hyprpanel/internal/control"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	"github.com/peterbourgon/ff/v4"
+	"github.com/peterbourgon/ff/v4/ffhelp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	name    = `hyprpanelctl`
+	timeout = 5 * time.Second
+)
+
+var errUsage = errors.New(`invalid arguments`)
+
+type ctl struct {
+	socket  string
+	jsonOut bool
+	client  hyprpanelv1.ControlServiceClient
+}
+
+func (c *ctl) connect(fn func(ctx context.Context, args []string) error) func(ctx context.Context, args []string) error {
+	return func(ctx context.Context, args []string) error {
+		client, conn, err := control.Dial(c.socket)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = conn.Close()
+		}()
+		c.client = client
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return fn(ctx, args)
+	}
+}
+
+func (c *ctl) status(ctx context.Context, _ []string) error {
+	res, err := c.client.Status(ctx, &hyprpanelv1.ControlServiceStatusRequest{})
+	if err != nil {
+		return err
+	}
+
+	if c.jsonOut {
+		b, err := protojson.MarshalOptions{Multiline: true, Indent: "\t", UseProtoNames: true}.Marshal(res)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	fmt.Printf("version:\t%s\npid:\t\t%d\nlog level:\t%s\ndbus:\t\t%t\naudio:\t\t%t\n\n",
+		res.Version, res.Pid, res.LogLevel, res.DbusEnabled, res.AudioEnabled)
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PANEL\tMONITOR\tEDGE\tVISIBLE\tRESTARTS\tDROPPED\tSTATE")
+	for _, p := range res.Panels {
+		state := `running`
+		switch {
+		case p.Abandoned:
+			state = `failed: ` + p.LastError
+		case !p.Running:
+			state = `restarting: ` + p.LastError
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%d\t%d\t%s\n", p.Id, p.Monitor, p.Edge, p.Visible, p.Restarts, p.DroppedEvents, state)
+	}
+
+	return w.Flush()
+}
+
+func (c *ctl) notify(ctx context.Context, msg proto.Message, kind eventv1.EventKind) error {
+	data, err := anypb.New(msg)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.Notify(ctx, &hyprpanelv1.ControlServiceNotifyRequest{
+		Event: &eventv1.Event{Kind: kind, Data: data},
+	})
+
+	return err
+}
+
+func (c *ctl) event(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	evt := &eventv1.Event{}
+	if err := protojson.Unmarshal([]byte(args[0]), evt); err != nil {
+		return fmt.Errorf("failed parsing event: %w", err)
+	}
+	_, err := c.client.Notify(ctx, &hyprpanelv1.ControlServiceNotifyRequest{Event: evt})
+
+	return err
+}
+
+func parseDirection(arg string) (eventv1.Direction, error) {
+	switch strings.ToLower(arg) {
+	case `up`, `+`:
+		return eventv1.Direction_DIRECTION_UP, nil
+	case `down`, `-`:
+		return eventv1.Direction_DIRECTION_DOWN, nil
+	default:
+		return eventv1.Direction_DIRECTION_UNSPECIFIED, fmt.Errorf("%w: unknown direction %q", errUsage, arg)
+	}
+}
+
+func (c *ctl) volume(source *bool, id *string) func(ctx context.Context, args []string) error {
+	return func(ctx context.Context, args []string) error {
+		if len(args) != 1 {
+			return errUsage
+		}
+		if strings.EqualFold(args[0], `mute`) {
+			if *source {
+				dev := *id
+				if dev == `` {
+					dev = eventv1.AudioDefaultSource
+				}
+				return c.notify(ctx, &eventv1.AudioSourceMuteToggle{Id: dev}, eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_MUTE_TOGGLE)
+			}
+			dev := *id
+			if dev == `` {
+				dev = eventv1.AudioDefaultSink
+			}
+			return c.notify(ctx, &eventv1.AudioSinkMuteToggle{Id: dev}, eventv1.EventKind_EVENT_KIND_AUDIO_SINK_MUTE_TOGGLE)
+		}
+
+		direction, err := parseDirection(args[0])
+		if err != nil {
+			return err
+		}
+		if *source {
+			dev := *id
+			if dev == `` {
+				dev = eventv1.AudioDefaultSource
+			}
+			return c.notify(ctx, &eventv1.AudioSourceVolumeAdjust{Id: dev, Direction: direction}, eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_VOLUME_ADJUST)
+		}
+		dev := *id
+		if dev == `` {
+			dev = eventv1.AudioDefaultSink
+		}
+		return c.notify(ctx, &eventv1.AudioSinkVolumeAdjust{Id: dev, Direction: direction}, eventv1.EventKind_EVENT_KIND_AUDIO_SINK_VOLUME_ADJUST)
+	}
+}
+
+func (c *ctl) brightness(device *string) func(ctx context.Context, args []string) error {
+	return func(ctx context.Context, args []string) error {
+		if len(args) != 1 {
+			return errUsage
+		}
+		direction, err := parseDirection(args[0])
+		if err != nil {
+			return err
+		}
+		return c.notify(ctx, &eventv1.BrightnessAdjustValue{DevName: *device, Direction: direction}, eventv1.EventKind_EVENT_KIND_DBUS_BRIGHTNESS_ADJUST)
+	}
+}
+
+func (c *ctl) exec(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	return c.notify(ctx, &hyprpanelv1.AppInfo_Action{Exec: args}, eventv1.EventKind_EVENT_KIND_EXEC)
+}
+
+func (c *ctl) setVisible(visible bool) func(ctx context.Context, args []string) error {
+	return func(ctx context.Context, args []string) error {
+		_, err := c.client.PanelSetVisible(ctx, &hyprpanelv1.ControlServicePanelSetVisibleRequest{
+			Ids:     args,
+			Visible: visible,
+		})
+		return err
+	}
+}
+
+func (c *ctl) reload(ctx context.Context, _ []string) error {
+	_, err := c.client.Reload(ctx, &hyprpanelv1.ControlServiceReloadRequest{})
+	return err
+}
+
+func main() {
+	c := &ctl{}
+	socketDefault, _ := control.SocketPath()
+
+	rootFlags := ff.NewFlagSet(name)
+	rootFlags.StringVar(&c.socket, 0, `socket`, socketDefault, `Path to hyprpanel control socket`)
+	root := &ff.Command{
+		Name:      name,
+		Usage:     name + ` [FLAGS] <SUBCOMMAND> ...`,
+		ShortHelp: `control a running hyprpanel host`,
+		Flags:     rootFlags,
+	}
+
+	statusFlags := ff.NewFlagSet(`status`).SetParent(rootFlags)
+	statusFlags.BoolVar(&c.jsonOut, 0, `json`, `Output status as JSON`)
+	status := &ff.Command{
+		Name:      `status`,
+		Usage:     name + ` status [--json]`,
+		ShortHelp: `display host state`,
+		Flags:     statusFlags,
+		Exec:      c.connect(c.status),
+	}
+
+	volumeFlags := ff.NewFlagSet(`volume`).SetParent(rootFlags)
+	volumeSource := volumeFlags.BoolLong(`source`, `Adjust the input device instead of the output device`)
+	volumeID := volumeFlags.StringLong(`id`, ``, `Audio device name (default device if empty)`)
+	volume := &ff.Command{
+		Name:      `volume`,
+		Usage:     name + ` volume [--source] [--id ID] <up|down|mute>`,
+		ShortHelp: `adjust audio volume`,
+		Flags:     volumeFlags,
+		Exec:      c.connect(c.volume(volumeSource, volumeID)),
+	}
+
+	brightnessFlags := ff.NewFlagSet(`brightness`).SetParent(rootFlags)
+	brightnessDevice := brightnessFlags.StringLong(`device`, ``, `Backlight device name (all devices if empty)`)
+	brightness := &ff.Command{
+		Name:      `brightness`,
+		Usage:     name + ` brightness [--device DEV] <up|down>`,
+		ShortHelp: `adjust display brightness`,
+		Flags:     brightnessFlags,
+		Exec:      c.connect(c.brightness(brightnessDevice)),
+	}
+
+	exec := &ff.Command{
+		Name:      `exec`,
+		Usage:     name + ` exec <COMMAND> [ARG...]`,
+		ShortHelp: `launch a command via the host (honours launch_wrapper)`,
+		Flags:     ff.NewFlagSet(`exec`).SetParent(rootFlags),
+		Exec:      c.connect(c.exec),
+	}
+
+	event := &ff.Command{
+		Name:      `event`,
+		Usage:     name + ` event <JSON>`,
+		ShortHelp: `inject a raw hyprpanel.event.v1.Event encoded as protojson`,
+		Flags:     ff.NewFlagSet(`event`).SetParent(rootFlags),
+		Exec:      c.connect(c.event),
+	}
+
+	show := &ff.Command{
+		Name:      `show`,
+		Usage:     name + ` show [PANEL_ID...]`,
+		ShortHelp: `show panels (all panels if none specified)`,
+		Flags:     ff.NewFlagSet(`show`).SetParent(rootFlags),
+		Exec:      c.connect(c.setVisible(true)),
+	}
+
+	hide := &ff.Command{
+		Name:      `hide`,
+		Usage:     name + ` hide [PANEL_ID...]`,
+		ShortHelp: `hide panels (all panels if none specified)`,
+		Flags:     ff.NewFlagSet(`hide`).SetParent(rootFlags),
+		Exec:      c.connect(c.setVisible(false)),
+	}
+
+	reload := &ff.Command{
+		Name:      `reload`,
+		Usage:     name + ` reload`,
+		ShortHelp: `reload configuration from disk`,
+		Flags:     ff.NewFlagSet(`reload`).SetParent(rootFlags),
+		Exec:      c.connect(c.reload),
+	}
+
+	root.Subcommands = []*ff.Command{status, volume, brightness, exec, event, show, hide, reload}
+
+	if err := root.Parse(os.Args[1:], ff.WithEnvVarPrefix(`HYPRPANEL`)); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", ffhelp.Command(root.GetSelected()))
+		if errors.Is(err, ff.ErrHelp) {
+			os.Exit(0)
+		}
+		fmt.Fprintf(os.Stderr, "err=%v\n", err)
+		os.Exit(1)
+	}
+
+	if err := root.Run(context.Background()); err != nil {
+		if errors.Is(err, errUsage) || errors.Is(err, ff.ErrNoExec) {
+			fmt.Fprintf(os.Stderr, "%s\n", ffhelp.Command(root.GetSelected()))
+		}
+		fmt.Fprintf(os.Stderr, "err=%v\n", err)
+		os.Exit(1)
+	}
+}
@@ -3,8 +3,8 @@ package config
 
 import (
 	_ "embed"
-	"io"
 	"os"
+	"path/filepath"
 
 	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -13,6 +13,14 @@ import (
 //go:embed default.json
 var defaultConfig []byte
 
+//go:embed schema.json
+var schema []byte
+
+// Schema returns the JSON Schema describing the configuration file format.
+func Schema() []byte {
+	return schema
+}
+
 // Default returns the default configuration values.
 func Default() (*configv1.Config, error) {
 	c := &configv1.Config{}
@@ -23,28 +31,23 @@ func Default() (*configv1.Config, error) {
 	return c, nil
 }
 
-// Load and parse a configuration file from disk.
+// Load and parse a configuration file from disk, the format is determined by
+// the file extension. Included files and overlays are merged, see LoadSources.
 func Load(filePath string) (*configv1.Config, error) {
-	f, err := os.Open(filePath)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		if err := f.Close(); err != nil {
-			panic(err)
-		}
-	}()
-
-	b, err := io.ReadAll(f)
-	if err != nil {
-		return nil, err
-	}
+	c, _, err := LoadSources(filePath)
 
-	c := &configv1.Config{}
+	return c, err
+}
 
-	if err := protojson.Unmarshal(b, c); err != nil {
-		return nil, err
+// Find returns the first configuration file found in dir, in FileNames order,
+// or the path to the preferred file name if none exist.
+func Find(dir string) string {
+	for _, name := range FileNames {
+		filePath := filepath.Join(dir, name)
+		if _, err := os.Stat(filePath); err == nil {
+			return filePath
+		}
 	}
 
-	return c, nil
+	return filepath.Join(dir, FileNames[0])
 }
@@ -0,0 +1,282 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	"github.com/tailscale/hujson"
+)
+
+// Origin identifies where an effective configuration value was defined.
+type Origin string
+
+const (
+	// OriginUser values are defined by the user configuration, including includes and overlays.
+	OriginUser Origin = `user`
+	// OriginDefault values are inherited from the default configuration.
+	OriginDefault Origin = `default`
+	// OriginMigrated values are set or changed by configuration migrations.
+	OriginMigrated Origin = `migrated`
+	// OriginUnset values are not defined by either, and take the zero value.
+	OriginUnset Origin = `unset`
+)
+
+const versionKey = `version`
+
+// PrintEffective deep-merges the configuration file at filePath, including its
+// includes and overlays, over the default configuration, using the same rules
+// as includes, and migrates the result to the current version. A missing
+// configuration file is treated as empty. The result is encoded as JSON with a
+// trailing comment on each value identifying its Origin.
+func PrintEffective(filePath string) ([]byte, error) {
+	c, origins, err := effective(filePath)
+	if err != nil {
+		return nil, err
+	}
+	b, err := Marshal(c, FormatJSON)
+	if err != nil {
+		return nil, err
+	}
+	v, err := hujson.Parse(b)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	origins.write(buf, v, 0, ``)
+	buf.WriteByte('\n')
+
+	return buf.Bytes(), nil
+}
+
+// origins tracks the user, default and pre-migration values corresponding to a
+// position in the effective configuration.
+type origins struct {
+	user, def, pre       any
+	userOK, defOK, preOK bool
+}
+
+// origin returns the Origin of v, the effective value at this position.
+func (o origins) origin(v hujson.Value) Origin {
+	switch {
+	case o.migrated(v):
+		return OriginMigrated
+	case o.userOK:
+		return OriginUser
+	case o.defOK:
+		return OriginDefault
+	default:
+		return OriginUnset
+	}
+}
+
+// migrated reports whether v differs from the value before migration.
+func (o origins) migrated(v hujson.Value) bool {
+	if !o.preOK {
+		return true
+	}
+	var val any
+	dec := json.NewDecoder(bytes.NewReader(v.Pack()))
+	dec.UseNumber()
+	if err := dec.Decode(&val); err != nil {
+		return false
+	}
+
+	return !reflect.DeepEqual(val, o.pre)
+}
+
+// member returns the origins for the named object member.
+func (o origins) member(name string) origins {
+	var child origins
+	child.user, child.userOK = lookup(o.user, name)
+	child.def, child.defOK = lookup(o.def, name)
+	child.pre, child.preOK = lookup(o.pre, name)
+
+	return child
+}
+
+// element returns the origins for the list element at index i. Panels are
+// matched by ID, as they are merged, other lists are replaced as a whole.
+func (o origins) element(i int, key string, id string) origins {
+	var child origins
+	if key == panelsKey && id != `` {
+		child.user, child.userOK = findPanel(o.user, id)
+		child.def, child.defOK = findPanel(o.def, id)
+		child.pre, child.preOK = findPanel(o.pre, id)
+		return child
+	}
+	if list, ok := o.user.([]any); ok && i < len(list) {
+		child.user, child.userOK = list[i], true
+	} else if list, ok := o.def.([]any); ok && i < len(list) && !o.userOK {
+		child.def, child.defOK = list[i], true
+	}
+	if list, ok := o.pre.([]any); ok && i < len(list) {
+		child.pre, child.preOK = list[i], true
+	}
+
+	return child
+}
+
+// write writes the non-empty object or array v, annotating its descendants.
+func (o origins) write(buf *bytes.Buffer, v hujson.Value, depth int, key string) {
+	indent := strings.Repeat("\t", depth+1)
+	switch val := v.Value.(type) {
+	case *hujson.Object:
+		buf.WriteString("{\n")
+		for i, m := range val.Members {
+			name := m.Name.Value.(hujson.Literal).String()
+			buf.WriteString(indent)
+			buf.Write(m.Name.Value.(hujson.Literal))
+			buf.WriteString(`: `)
+			o.member(name).writeValue(buf, m.Value, depth+1, name, i == len(val.Members)-1)
+		}
+		buf.WriteString(indent[1:])
+		buf.WriteByte('}')
+	case *hujson.Array:
+		buf.WriteString("[\n")
+		for i, e := range val.Elements {
+			var id string
+			if obj, ok := e.Value.(*hujson.Object); ok {
+				for _, m := range obj.Members {
+					if lit, ok := m.Value.Value.(hujson.Literal); ok && m.Name.Value.(hujson.Literal).String() == panelIDKey {
+						id = lit.String()
+					}
+				}
+			}
+			buf.WriteString(indent)
+			o.element(i, key, id).writeValue(buf, e, depth+1, ``, i == len(val.Elements)-1)
+		}
+		buf.WriteString(indent[1:])
+		buf.WriteByte(']')
+	}
+}
+
+// writeValue writes v as a member or element, followed by a separator and the
+// origin comment for literal values.
+func (o origins) writeValue(buf *bytes.Buffer, v hujson.Value, depth int, key string, last bool) {
+	switch val := v.Value.(type) {
+	case *hujson.Object:
+		if len(val.Members) == 0 {
+			buf.WriteString(`{}`)
+			o.writeComment(buf, v, !last)
+			return
+		}
+	case *hujson.Array:
+		if len(val.Elements) == 0 {
+			buf.WriteString(`[]`)
+			o.writeComment(buf, v, !last)
+			return
+		}
+	case hujson.Literal:
+		buf.Write(val)
+		o.writeComment(buf, v, !last)
+		return
+	}
+
+	o.write(buf, v, depth, key)
+	if !last {
+		buf.WriteByte(',')
+	}
+	buf.WriteByte('\n')
+}
+
+func (o origins) writeComment(buf *bytes.Buffer, v hujson.Value, comma bool) {
+	if comma {
+		buf.WriteByte(',')
+	}
+	buf.WriteString(` // `)
+	buf.WriteString(string(o.origin(v)))
+	buf.WriteByte('\n')
+}
+
+// effective returns the merged configuration, and the origins of its root.
+func effective(filePath string) (*configv1.Config, origins, error) {
+	user, _, err := loadValues(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		user, err = make(map[string]any), nil
+	}
+	if err != nil {
+		return nil, origins{}, err
+	}
+	def, err := defaultValues()
+	if err != nil {
+		return nil, origins{}, err
+	}
+	merged, err := defaultValues()
+	if err != nil {
+		return nil, origins{}, err
+	}
+	// The default version must not prevent migration of user configuration.
+	delete(merged, versionKey)
+	mergeValues(merged, user, true)
+
+	c, err := fromValues(merged)
+	if err != nil {
+		return nil, origins{}, err
+	}
+	pre, err := marshalValues(c)
+	if err != nil {
+		return nil, origins{}, err
+	}
+	if _, err := Migrate(c); err != nil {
+		return nil, origins{}, err
+	}
+
+	return c, origins{user: user, def: def, pre: pre, userOK: true, defOK: true, preOK: true}, nil
+}
+
+// marshalValues encodes c to generic values, as they are printed.
+func marshalValues(c *configv1.Config) (map[string]any, error) {
+	b, err := Marshal(c, FormatJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return numberValues(b)
+}
+
+func defaultValues() (map[string]any, error) {
+	return numberValues(defaultConfig)
+}
+
+// numberValues decodes the JSON object b to generic values, with numbers
+// decoded as json.Number.
+func numberValues(b []byte) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	values := make(map[string]any)
+	if err := dec.Decode(&values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func lookup(v any, name string) (any, bool) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	val, ok := m[name]
+
+	return val, ok
+}
+
+func findPanel(v any, id string) (any, bool) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, false
+	}
+	for _, e := range list {
+		if panel, ok := e.(map[string]any); ok && panel[panelIDKey] == id {
+			return panel, true
+		}
+	}
+
+	return nil, false
+}
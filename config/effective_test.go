@@ -0,0 +1,92 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pdf/hyprpanel/config"
+)
+
+func TestPrintEffective(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		// want maps a fragment of a printed line to its expected origin.
+		want map[string]config.Origin
+	}{
+		{
+			name:    `user over defaults`,
+			content: "version: 2\nlog_level: LOG_LEVEL_DEBUG\naudio:\n  volume_step_percent: 10\n",
+			want: map[string]config.Origin{
+				`"log_level": "LOG_LEVEL_DEBUG"`: config.OriginUser,
+				`"volume_step_percent": 10`:      config.OriginUser,
+				`"volume_exceed_maximum": false`: config.OriginDefault,
+				`"connect_timeout": "20s"`:       config.OriginDefault,
+				`"log_subprocesses_to_journal"`:  config.OriginUnset,
+				`"version": 2`:                   config.OriginUser,
+			},
+		},
+		{
+			name:    `panels merged by id`,
+			content: "version: 2\npanels:\n  - id: panel0\n    size: 40\n",
+			want: map[string]config.Origin{
+				`"id": "panel0"`:       config.OriginUser,
+				`"size": 40`:           config.OriginUser,
+				`"edge": "EDGE_RIGHT"`: config.OriginDefault,
+			},
+		},
+		{
+			name:    `migrated`,
+			content: "version: 1\nlog_subprocesses_to_journal: true\nlaunch_wrapper: []\n",
+			want: map[string]config.Origin{
+				`"log_subprocesses_to_journal": false`: config.OriginMigrated,
+				`"systemd-cat"`:                        config.OriginMigrated,
+				`"version": 2`:                         config.OriginMigrated,
+				`"log_level": "LOG_LEVEL_INFO"`:        config.OriginDefault,
+			},
+		},
+		{
+			name: `missing file`,
+			want: map[string]config.Origin{
+				`"log_level": "LOG_LEVEL_INFO"`: config.OriginDefault,
+				`"id": "panel0"`:                config.OriginDefault,
+				`"log_subprocesses_to_journal"`: config.OriginUnset,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), `config.yaml`)
+			if tt.content != `` {
+				if err := os.WriteFile(filePath, []byte(tt.content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			b, err := config.PrintEffective(filePath)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			lines := strings.Split(string(b), "\n")
+			for fragment, origin := range tt.want {
+				found := false
+				for _, line := range lines {
+					if !strings.Contains(line, fragment) {
+						continue
+					}
+					found = true
+					if !strings.HasSuffix(line, ` // `+string(origin)) {
+						t.Errorf("got %q, want origin %s", strings.TrimSpace(line), origin)
+					}
+					break
+				}
+				if !found {
+					t.Errorf("missing %s", fragment)
+				}
+			}
+		})
+	}
+}
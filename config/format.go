@@ -0,0 +1,205 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/tailscale/hujson"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+)
+
+// Format identifies a configuration file encoding.
+type Format string
+
+const (
+	// FormatJSON is JSON, with support for comments and trailing commas (JSONC) when reading.
+	FormatJSON Format = `json`
+	// FormatYAML is YAML.
+	FormatYAML Format = `yaml`
+	// FormatTOML is TOML.
+	FormatTOML Format = `toml`
+)
+
+// FileNames lists the configuration file names searched by Find, in order of preference.
+var FileNames = []string{`config.json`, `config.jsonc`, `config.yaml`, `config.yml`, `config.toml`}
+
+// FormatFromPath determines the configuration format from the file extension.
+func FormatFromPath(filePath string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case `.json`, `.jsonc`:
+		return FormatJSON, nil
+	case `.yaml`, `.yml`:
+		return FormatYAML, nil
+	case `.toml`:
+		return FormatTOML, nil
+	default:
+		return ``, fmt.Errorf("unsupported configuration file extension: %s", filePath)
+	}
+}
+
+// Unmarshal decodes the configuration from b in the specified format. Included
+// files are not loaded, the include list is retained as-is.
+func Unmarshal(b []byte, format Format) (*configv1.Config, error) {
+	values, err := decodeValues(b, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromValues(values)
+}
+
+// Marshal encodes the configuration in the specified format.
+func Marshal(c *configv1.Config, format Format) ([]byte, error) {
+	marshal := protojson.MarshalOptions{
+		Multiline:       true,
+		Indent:          "\t",
+		EmitUnpopulated: true,
+		UseProtoNames:   true,
+	}
+	if format == FormatTOML {
+		// TOML has no representation for null values.
+		marshal.EmitUnpopulated = false
+	}
+
+	js, err := marshal.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case FormatJSON:
+		return js, nil
+	case FormatYAML:
+		// JSON is valid YAML, decoding to a node preserves field ordering.
+		var node yaml.Node
+		if err := yaml.Unmarshal(js, &node); err != nil {
+			return nil, err
+		}
+		setBlockStyle(&node)
+		buf := &bytes.Buffer{}
+		enc := yaml.NewEncoder(buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(&node); err != nil {
+			return nil, err
+		}
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case FormatTOML:
+		v, err := decodeJSON(js)
+		if err != nil {
+			return nil, err
+		}
+		return toml.Marshal(v)
+	default:
+		return nil, fmt.Errorf("unsupported configuration format: %s", format)
+	}
+}
+
+// Convert re-encodes configuration data from one format to another. Included
+// files are not loaded, the include list is retained as-is.
+func Convert(b []byte, from, to Format) ([]byte, error) {
+	c, err := Unmarshal(b, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return Marshal(c, to)
+}
+
+func toJSON(b []byte, format Format) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return hujson.Standardize(b)
+	case FormatYAML:
+		var v any
+		if err := yaml.Unmarshal(b, &v); err != nil {
+			return nil, err
+		}
+		return json.Marshal(v)
+	case FormatTOML:
+		var v map[string]any
+		if err := toml.Unmarshal(b, &v); err != nil {
+			return nil, err
+		}
+		return json.Marshal(v)
+	default:
+		return nil, fmt.Errorf("unsupported configuration format: %s", format)
+	}
+}
+
+// decodeValues decodes b in the specified format to generic values, without
+// the $schema key used by editors.
+func decodeValues(b []byte, format Format) (map[string]any, error) {
+	js, err := toJSON(b, format)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(js))
+	dec.UseNumber()
+	values := make(map[string]any)
+	if err := dec.Decode(&values); err != nil {
+		return nil, err
+	}
+	if values == nil {
+		values = make(map[string]any)
+	}
+	delete(values, schemaKey)
+
+	return values, nil
+}
+
+// decodeJSON decodes JSON into generic values, retaining integers so that they
+// are not encoded as floats in other formats.
+func decodeJSON(b []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return normalizeNumbers(v), nil
+}
+
+func normalizeNumbers(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, e := range val {
+			val[k] = normalizeNumbers(e)
+		}
+	case []any:
+		for i, e := range val {
+			val[i] = normalizeNumbers(e)
+		}
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		if f, err := val.Float64(); err == nil {
+			return f
+		}
+		return val.String()
+	}
+
+	return v
+}
+
+func setBlockStyle(node *yaml.Node) {
+	if node.Kind == yaml.MappingNode || node.Kind == yaml.SequenceNode {
+		node.Style = 0
+	}
+	if node.Kind == yaml.ScalarNode && node.Style == yaml.DoubleQuotedStyle && node.Tag == `!!str` {
+		node.Style = 0
+	}
+	for _, child := range node.Content {
+		setBlockStyle(child)
+	}
+}
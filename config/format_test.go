@@ -0,0 +1,146 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pdf/hyprpanel/config"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFormatFromPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    config.Format
+		wantErr bool
+	}{
+		{path: `config.json`, want: config.FormatJSON},
+		{path: `config.jsonc`, want: config.FormatJSON},
+		{path: `config.YAML`, want: config.FormatYAML},
+		{path: `config.yml`, want: config.FormatYAML},
+		{path: `config.toml`, want: config.FormatTOML},
+		{path: `config.ini`, wantErr: true},
+		{path: `config`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := config.FormatFromPath(tt.path)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name   string
+		format config.Format
+		data   string
+	}{
+		{
+			name:   `jsonc`,
+			format: config.FormatJSON,
+			data: `{
+	// comment
+	"$schema": "./schema.json",
+	"log_level": "LOG_LEVEL_DEBUG",
+	"panels": [{"id": "panel0", "size": 48},],
+}`,
+		},
+		{
+			name:   `yaml`,
+			format: config.FormatYAML,
+			data: `$schema: ./schema.json
+log_level: LOG_LEVEL_DEBUG
+panels:
+  - id: panel0
+    size: 48
+`,
+		},
+		{
+			name:   `toml`,
+			format: config.FormatTOML,
+			data: `log_level = "LOG_LEVEL_DEBUG"
+
+[[panels]]
+id = "panel0"
+size = 48
+`,
+		},
+		{
+			name:   `json names`,
+			format: config.FormatYAML,
+			data: `logLevel: LOG_LEVEL_DEBUG
+panels:
+  - id: panel0
+    size: 48
+`,
+		},
+	}
+
+	want := &configv1.Config{
+		LogLevel: configv1.LogLevel_LOG_LEVEL_DEBUG,
+		Panels:   []*configv1.Panel{{Id: `panel0`, Size: 48}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := config.Unmarshal([]byte(tt.data), tt.format)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !proto.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	def, err := config.Default()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, format := range []config.Format{config.FormatJSON, config.FormatYAML, config.FormatTOML} {
+		t.Run(string(format), func(t *testing.T) {
+			b, err := config.Marshal(def, format)
+			if err != nil {
+				t.Fatalf("failed marshaling: %v", err)
+			}
+			got, err := config.Unmarshal(b, format)
+			if err != nil {
+				t.Fatalf("failed unmarshaling: %v", err)
+			}
+			if !proto.Equal(got, def) {
+				t.Errorf("round trip changed configuration:\n%s", b)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	dir := t.TempDir()
+	if got, want := config.Find(dir), filepath.Join(dir, `config.json`); got != want {
+		t.Errorf("got %s for empty dir, want %s", got, want)
+	}
+	for _, name := range []string{`config.toml`, `config.yaml`} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := config.Find(dir), filepath.Join(dir, `config.yaml`); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,304 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// OverlayDirName is the name of the directory alongside the main configuration
+// file containing machine-specific overlay files.
+const OverlayDirName = `config.d`
+
+const (
+	includeKey = `include`
+	schemaKey  = `$schema`
+	panelsKey  = `panels`
+	panelIDKey = `id`
+)
+
+// OverlayDir returns the overlay directory for the configuration file at filePath.
+func OverlayDir(filePath string) string {
+	return filepath.Join(filepath.Dir(filePath), OverlayDirName)
+}
+
+// Overlays returns the overlay files for the configuration file at filePath, in merge order.
+func Overlays(filePath string) ([]string, error) {
+	entries, err := os.ReadDir(OverlayDir(filePath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	overlays := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), `.`) {
+			continue
+		}
+		if _, err := FormatFromPath(entry.Name()); err != nil {
+			continue
+		}
+		overlays = append(overlays, filepath.Join(OverlayDir(filePath), entry.Name()))
+	}
+	slices.Sort(overlays)
+
+	return overlays, nil
+}
+
+// Sources describes the files a configuration was assembled from.
+type Sources struct {
+	// Files are the absolute paths of all files read.
+	Files []string
+	// Includes are the absolute include patterns, which may match files that
+	// did not exist when the configuration was loaded.
+	Includes []string
+}
+
+// LoadSources loads the configuration file at filePath deep-merged over its
+// included files, followed by any overlays in the config.d directory. Returns
+// the merged configuration, and the sources it was assembled from.
+func LoadSources(filePath string) (*configv1.Config, *Sources, error) {
+	root, sources, err := loadValues(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c, err := fromValues(root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c, sources, nil
+}
+
+// loadValues loads the configuration file at filePath with its includes and
+// overlays as generic values, and returns the sources read.
+func loadValues(filePath string) (map[string]any, *Sources, error) {
+	l := &loader{loading: make(map[string]struct{})}
+	root, err := l.load(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	overlays, err := Overlays(filePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed reading overlay dir: %w", err)
+	}
+	for _, overlay := range overlays {
+		values, err := l.load(overlay)
+		if err != nil {
+			return nil, nil, err
+		}
+		delete(values, includeKey)
+		mergeValues(root, values, true)
+	}
+
+	return root, &Sources{Files: l.files, Includes: l.includes}, nil
+}
+
+// fromValues decodes generic values to a configuration.
+func fromValues(values map[string]any) (*configv1.Config, error) {
+	b, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	c := &configv1.Config{}
+	if err := protojson.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+type loader struct {
+	loading  map[string]struct{}
+	files    []string
+	includes []string
+}
+
+// load decodes the file at filePath to generic values, merged over its includes.
+func (l *loader) load(filePath string) (map[string]any, error) {
+	filePath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := l.loading[filePath]; ok {
+		return nil, fmt.Errorf("include cycle detected: %s", filePath)
+	}
+	l.loading[filePath] = struct{}{}
+	defer delete(l.loading, filePath)
+
+	b, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(l.files, filePath) {
+		l.files = append(l.files, filePath)
+	}
+
+	format, err := FormatFromPath(filePath)
+	if err != nil {
+		return nil, err
+	}
+	values, err := decodeValues(b, format)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filePath, err)
+	}
+	// Files may mix proto and JSON field names, normalize them for merging.
+	protoNames(values, (&configv1.Config{}).ProtoReflect().Descriptor())
+
+	includes, patterns, err := includePaths(filePath, values[includeKey])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filePath, err)
+	}
+	for _, pattern := range patterns {
+		if !slices.Contains(l.includes, pattern) {
+			l.includes = append(l.includes, pattern)
+		}
+	}
+	if len(includes) == 0 {
+		return values, nil
+	}
+
+	// Includes are merged in order, and the including file over them, so that
+	// it may override values from the files it includes.
+	merged := make(map[string]any)
+	for _, include := range includes {
+		included, err := l.load(include)
+		if err != nil {
+			return nil, err
+		}
+		delete(included, includeKey)
+		mergeValues(merged, included, true)
+	}
+	mergeValues(merged, values, true)
+
+	return merged, nil
+}
+
+// includePaths resolves the include list relative to the including file,
+// expanding environment variables and glob patterns. Returns the matched paths,
+// and the resolved patterns.
+func includePaths(filePath string, value any) ([]string, []string, error) {
+	if value == nil {
+		return nil, nil, nil
+	}
+	list, ok := value.([]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s: must be a list of file paths", includeKey)
+	}
+
+	paths := make([]string, 0, len(list))
+	patterns := make([]string, 0, len(list))
+	for i, v := range list {
+		include, ok := v.(string)
+		if !ok || include == `` {
+			return nil, nil, fmt.Errorf("%s[%d]: must be a file path", includeKey, i)
+		}
+		include = os.ExpandEnv(include)
+		if !filepath.IsAbs(include) {
+			include = filepath.Join(filepath.Dir(filePath), include)
+		}
+		matches, err := filepath.Glob(include)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s[%d]: %w", includeKey, i, err)
+		}
+		if len(matches) == 0 && !strings.ContainsAny(include, `*?[`) {
+			return nil, nil, fmt.Errorf("%s[%d]: %w", includeKey, i, &os.PathError{Op: `open`, Path: include, Err: os.ErrNotExist})
+		}
+		paths = append(paths, matches...)
+		patterns = append(patterns, include)
+	}
+
+	return paths, patterns, nil
+}
+
+// mergeValues deep-merges src into dst. Objects are merged recursively, all
+// other values are replaced, except for the top-level panel list, which is
+// merged by panel ID.
+func mergeValues(dst, src map[string]any, top bool) {
+	for k, v := range src {
+		if top && k == panelsKey {
+			dst[k] = mergePanels(dst[k], v)
+			continue
+		}
+		srcMap, srcOK := v.(map[string]any)
+		dstMap, dstOK := dst[k].(map[string]any)
+		if srcOK && dstOK {
+			mergeValues(dstMap, srcMap, false)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+func mergePanels(dst, src any) any {
+	dstList, dstOK := dst.([]any)
+	srcList, srcOK := src.([]any)
+	if !dstOK || !srcOK {
+		return src
+	}
+
+	for _, v := range srcList {
+		srcPanel, ok := v.(map[string]any)
+		if !ok {
+			dstList = append(dstList, v)
+			continue
+		}
+		id, _ := srcPanel[panelIDKey].(string)
+		merged := false
+		if id != `` {
+			for _, d := range dstList {
+				if dstPanel, ok := d.(map[string]any); ok && dstPanel[panelIDKey] == id {
+					mergeValues(dstPanel, srcPanel, false)
+					merged = true
+					break
+				}
+			}
+		}
+		if !merged {
+			dstList = append(dstList, srcPanel)
+		}
+	}
+
+	return dstList
+}
+
+// protoNames renames fields in values specified by their JSON name to their
+// proto name, so that values may be merged with the defaults.
+func protoNames(values map[string]any, md protoreflect.MessageDescriptor) {
+	fields := md.Fields()
+	for k, v := range values {
+		fd := fields.ByName(protoreflect.Name(k))
+		if fd == nil {
+			fd = fields.ByJSONName(k)
+			if fd == nil {
+				continue
+			}
+			delete(values, k)
+			values[string(fd.Name())] = v
+		}
+		if fd.Message() == nil {
+			continue
+		}
+		switch val := v.(type) {
+		case map[string]any:
+			protoNames(val, fd.Message())
+		case []any:
+			for _, e := range val {
+				if m, ok := e.(map[string]any); ok {
+					protoNames(m, fd.Message())
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/pdf/hyprpanel/config"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+)
+
+// writeFiles writes files, keyed by path relative to dir.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLoadSourcesPrecedence(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		wantLevel configv1.LogLevel
+		wantSize  uint32
+	}{
+		{
+			name: `includer overrides include`,
+			files: map[string]string{
+				`config.yaml`: "include: [base.yaml]\nlog_level: LOG_LEVEL_DEBUG\n",
+				`base.yaml`:   "log_level: LOG_LEVEL_WARN\n",
+			},
+			wantLevel: configv1.LogLevel_LOG_LEVEL_DEBUG,
+		},
+		{
+			name: `include fills unset values`,
+			files: map[string]string{
+				`config.yaml`: "include: [base.yaml]\n",
+				`base.yaml`:   "log_level: LOG_LEVEL_WARN\n",
+			},
+			wantLevel: configv1.LogLevel_LOG_LEVEL_WARN,
+		},
+		{
+			name: `later include overrides earlier`,
+			files: map[string]string{
+				`config.yaml`: "include: [a.yaml, b.yaml]\n",
+				`a.yaml`:      "log_level: LOG_LEVEL_WARN\n",
+				`b.yaml`:      "log_level: LOG_LEVEL_ERROR\n",
+			},
+			wantLevel: configv1.LogLevel_LOG_LEVEL_ERROR,
+		},
+		{
+			name: `nested includer overrides its include`,
+			files: map[string]string{
+				`config.yaml`: "include: [a.yaml]\n",
+				`a.yaml`:      "include: [b.yaml]\nlog_level: LOG_LEVEL_WARN\n",
+				`b.yaml`:      "log_level: LOG_LEVEL_ERROR\n",
+			},
+			wantLevel: configv1.LogLevel_LOG_LEVEL_WARN,
+		},
+		{
+			name: `overlay overrides includer`,
+			files: map[string]string{
+				`config.yaml`:          "include: [base.yaml]\nlog_level: LOG_LEVEL_DEBUG\n",
+				`base.yaml`:            "log_level: LOG_LEVEL_WARN\n",
+				`config.d/laptop.yaml`: "log_level: LOG_LEVEL_ERROR\n",
+			},
+			wantLevel: configv1.LogLevel_LOG_LEVEL_ERROR,
+		},
+		{
+			name: `panels merged by id`,
+			files: map[string]string{
+				`config.yaml`: "include: [base.yaml]\npanels:\n  - id: panel0\n    size: 48\n",
+				`base.yaml`:   "log_level: LOG_LEVEL_WARN\npanels:\n  - id: panel0\n    size: 32\n    monitor: DP-1\n",
+			},
+			wantLevel: configv1.LogLevel_LOG_LEVEL_WARN,
+			wantSize:  48,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+			cfg, _, err := config.LoadSources(filepath.Join(dir, `config.yaml`))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cfg.LogLevel != tt.wantLevel {
+				t.Errorf("got log level %v, want %v", cfg.LogLevel, tt.wantLevel)
+			}
+			if tt.wantSize == 0 {
+				return
+			}
+			if len(cfg.Panels) != 1 {
+				t.Fatalf("got %d panels, want 1", len(cfg.Panels))
+			}
+			if cfg.Panels[0].Size != tt.wantSize || cfg.Panels[0].Monitor != `DP-1` {
+				t.Errorf("unexpected panel: %v", cfg.Panels[0])
+			}
+		})
+	}
+}
+
+func TestLoadSourcesIncludes(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		`config.yaml`:           "include: [base.yaml, 'conf/*.yaml', 'missing/*.yaml']\n",
+		`base.yaml`:             "log_level: LOG_LEVEL_WARN\n",
+		`conf/panels.yaml`:      "panels: []\n",
+		`config.d/overlay.yaml`: "log_level: LOG_LEVEL_ERROR\n",
+	})
+	_, sources, err := config.LoadSources(filepath.Join(dir, `config.yaml`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantFiles := []string{
+		filepath.Join(dir, `config.yaml`),
+		filepath.Join(dir, `base.yaml`),
+		filepath.Join(dir, `conf`, `panels.yaml`),
+		filepath.Join(dir, `config.d`, `overlay.yaml`),
+	}
+	if !slices.Equal(sources.Files, wantFiles) {
+		t.Errorf("got files %v, want %v", sources.Files, wantFiles)
+	}
+	wantIncludes := []string{
+		filepath.Join(dir, `base.yaml`),
+		filepath.Join(dir, `conf`, `*.yaml`),
+		filepath.Join(dir, `missing`, `*.yaml`),
+	}
+	if !slices.Equal(sources.Includes, wantIncludes) {
+		t.Errorf("got includes %v, want %v", sources.Includes, wantIncludes)
+	}
+}
+
+func TestLoadSourcesErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{
+			name: `cycle`,
+			files: map[string]string{
+				`config.yaml`: "include: [a.yaml]\n",
+				`a.yaml`:      "include: [config.yaml]\n",
+			},
+		},
+		{
+			name: `missing include`,
+			files: map[string]string{
+				`config.yaml`: "include: [missing.yaml]\n",
+			},
+		},
+		{
+			name: `invalid include list`,
+			files: map[string]string{
+				`config.yaml`: "include: base.yaml\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+			if _, _, err := config.LoadSources(filepath.Join(dir, `config.yaml`)); err == nil {
+				t.Fatal(`expected error`)
+			}
+		})
+	}
+}
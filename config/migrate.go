@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+)
+
+// CurrentVersion is the configuration version produced by this release.
+const CurrentVersion = 2
+
+// Migration upgrades a configuration from one version to the next.
+type Migration struct {
+	// From is the version this migration applies to, the result is version From+1.
+	From uint32
+	// Apply performs the migration in place, and returns a description of each change made.
+	Apply func(c *configv1.Config) []string
+}
+
+// Change describes a modification made while migrating a configuration.
+type Change struct {
+	From        uint32
+	To          uint32
+	Description string
+}
+
+// String implements fmt.Stringer.
+func (c Change) String() string {
+	return fmt.Sprintf("v%d -> v%d: %s", c.From, c.To, c.Description)
+}
+
+// migrations must be ordered by From, with no gaps.
+var migrations = []Migration{
+	{From: 1, Apply: migrateLaunchWrapper},
+}
+
+// Migrate upgrades the configuration to CurrentVersion in place, and returns the changes made.
+func Migrate(c *configv1.Config) ([]Change, error) {
+	version := c.Version
+	if version == 0 {
+		version = 1
+	}
+	if version > CurrentVersion {
+		return nil, fmt.Errorf("configuration version %d is newer than supported version %d", version, CurrentVersion)
+	}
+
+	var changes []Change
+	for _, m := range migrations {
+		if m.From < version {
+			continue
+		}
+		if m.From != version {
+			return changes, fmt.Errorf("no migration available from configuration version %d", version)
+		}
+		for _, desc := range m.Apply(c) {
+			changes = append(changes, Change{From: m.From, To: m.From + 1, Description: desc})
+		}
+		version = m.From + 1
+	}
+
+	c.Version = version
+
+	return changes, nil
+}
+
+// migrateLaunchWrapper replaces the deprecated log_subprocesses_to_journal option with the equivalent launch_wrapper.
+func migrateLaunchWrapper(c *configv1.Config) []string {
+	if !c.LogSubprocessesToJournal {
+		return nil
+	}
+	c.LogSubprocessesToJournal = false
+
+	if len(c.LaunchWrapper) > 0 {
+		return []string{`removed log_subprocesses_to_journal, launch_wrapper is already configured`}
+	}
+	c.LaunchWrapper = []string{`systemd-cat`}
+
+	return []string{`replaced log_subprocesses_to_journal with launch_wrapper ["systemd-cat"]`}
+}
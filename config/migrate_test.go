@@ -0,0 +1,93 @@
+package config_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/pdf/hyprpanel/config"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+)
+
+func TestMigrate(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         *configv1.Config
+		wantChanges int
+		wantWrapper []string
+		wantErr     bool
+	}{
+		{
+			name:        `unversioned without deprecated options`,
+			cfg:         &configv1.Config{},
+			wantChanges: 0,
+		},
+		{
+			name:        `journal logging`,
+			cfg:         &configv1.Config{Version: 1, LogSubprocessesToJournal: true},
+			wantChanges: 1,
+			wantWrapper: []string{`systemd-cat`},
+		},
+		{
+			name:        `journal logging with existing wrapper`,
+			cfg:         &configv1.Config{LogSubprocessesToJournal: true, LaunchWrapper: []string{`uwsm`, `app`, `--`}},
+			wantChanges: 1,
+			wantWrapper: []string{`uwsm`, `app`, `--`},
+		},
+		{
+			name:        `current`,
+			cfg:         &configv1.Config{Version: config.CurrentVersion, LogSubprocessesToJournal: true},
+			wantChanges: 0,
+		},
+		{
+			name:    `newer`,
+			cfg:     &configv1.Config{Version: config.CurrentVersion + 1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := config.Migrate(tt.cfg)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal(`expected error`)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(changes) != tt.wantChanges {
+				t.Errorf("got changes %v, want %d", changes, tt.wantChanges)
+			}
+			if tt.cfg.Version != config.CurrentVersion {
+				t.Errorf("got version %d, want %d", tt.cfg.Version, config.CurrentVersion)
+			}
+			if tt.wantWrapper != nil {
+				if tt.cfg.LogSubprocessesToJournal {
+					t.Error(`log_subprocesses_to_journal not cleared`)
+				}
+				if !slices.Equal(tt.cfg.LaunchWrapper, tt.wantWrapper) {
+					t.Errorf("got launch_wrapper %v, want %v", tt.cfg.LaunchWrapper, tt.wantWrapper)
+				}
+			}
+		})
+	}
+}
+
+func TestDefaultIsCurrent(t *testing.T) {
+	def, err := config.Default()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Version != config.CurrentVersion {
+		t.Errorf("default configuration is version %d, want %d", def.Version, config.CurrentVersion)
+	}
+	changes, err := config.Migrate(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("default configuration required migration: %v", changes)
+	}
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"path"
+	"strings"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// InstanceSeparator separates the template panel ID from the monitor name in
+// the IDs of panels instantiated from a template.
+const InstanceSeparator = `@`
+
+// IsTemplate reports whether the panel is a template, instantiated once for
+// each matching monitor.
+func IsTemplate(panel *configv1.Panel) bool {
+	return len(panel.Monitors) > 0 || isPattern(panel.Monitor)
+}
+
+// MonitorPatterns returns the monitor name patterns for a template panel.
+func MonitorPatterns(panel *configv1.Panel) []string {
+	if len(panel.Monitors) > 0 {
+		return panel.Monitors
+	}
+	if isPattern(panel.Monitor) {
+		return []string{panel.Monitor}
+	}
+
+	return nil
+}
+
+// ExpandPanels returns the concrete panels to run on the named monitors. Each
+// template panel is replaced by an instance for each matching monitor, with
+// the ID "<id>@<monitor>", other panels are returned as-is.
+func ExpandPanels(panels []*configv1.Panel, monitors []string) []*configv1.Panel {
+	expanded := make([]*configv1.Panel, 0, len(panels))
+	for _, panel := range panels {
+		if !IsTemplate(panel) {
+			expanded = append(expanded, panel)
+			continue
+		}
+		patterns := MonitorPatterns(panel)
+		for _, monitor := range monitors {
+			if !matchMonitor(patterns, monitor) {
+				continue
+			}
+			inst := proto.Clone(panel).(*configv1.Panel)
+			inst.Id = panel.Id + InstanceSeparator + monitor
+			inst.Monitor = monitor
+			inst.Monitors = nil
+			expanded = append(expanded, inst)
+		}
+	}
+
+	return expanded
+}
+
+func matchMonitor(patterns []string, monitor string) bool {
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, monitor); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isPattern(s string) bool {
+	return strings.ContainsAny(s, `*?[`)
+}
+
+// validPattern reports malformed monitor name patterns.
+func validPattern(pattern string) error {
+	_, err := path.Match(pattern, ``)
+
+	return err
+}
@@ -0,0 +1,162 @@
+package config_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/pdf/hyprpanel/config"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// schemaValidator checks values against the subset of JSON Schema keywords
+// produced by protoc-gen-jsonschema.
+type schemaValidator struct {
+	defs map[string]any
+}
+
+func (v *schemaValidator) validate(path string, schema map[string]any, value any) error {
+	if ref, ok := schema[`$ref`].(string); ok {
+		def, ok := v.defs[strings.TrimPrefix(ref, `#/$defs/`)].(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: unresolved reference %s", path, ref)
+		}
+		return v.validate(path, def, value)
+	}
+
+	if enum, ok := schema[`enum`].([]any); ok && !slices.Contains(enum, value) {
+		return fmt.Errorf("%s: %v is not one of %v", path, value, enum)
+	}
+
+	switch schema[`type`] {
+	case `object`:
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: expected object", path)
+		}
+		props, _ := schema[`properties`].(map[string]any)
+		for k, e := range obj {
+			prop, ok := props[k].(map[string]any)
+			if !ok {
+				if schema[`additionalProperties`] == false {
+					return fmt.Errorf("%s: unknown property %s", path, k)
+				}
+				continue
+			}
+			if err := v.validate(path+`.`+k, prop, e); err != nil {
+				return err
+			}
+		}
+		if oneOf, ok := schema[`oneOf`].([]any); ok {
+			matched := 0
+			for _, s := range oneOf {
+				required, _ := s.(map[string]any)[`required`].([]any)
+				if slices.ContainsFunc(required, func(r any) bool { _, ok := obj[r.(string)]; return ok }) {
+					matched++
+				}
+			}
+			if matched != 1 {
+				return fmt.Errorf("%s: matched %d of oneOf", path, matched)
+			}
+		}
+	case `array`:
+		list, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected array", path)
+		}
+		items, _ := schema[`items`].(map[string]any)
+		for i, e := range list {
+			if err := v.validate(fmt.Sprintf("%s[%d]", path, i), items, e); err != nil {
+				return err
+			}
+		}
+	case `string`:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s: expected string", path)
+		}
+		if pattern, ok := schema[`pattern`].(string); ok && !regexp.MustCompile(pattern).MatchString(s) {
+			return fmt.Errorf("%s: %q does not match %s", path, s, pattern)
+		}
+	case `integer`, `number`:
+		n, ok := value.(json.Number)
+		if !ok {
+			return fmt.Errorf("%s: expected number", path)
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if min, ok := schema[`minimum`].(json.Number); ok {
+			if m, _ := min.Float64(); f < m {
+				return fmt.Errorf("%s: %v is less than minimum %v", path, f, m)
+			}
+		}
+	case `boolean`:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: expected boolean", path)
+		}
+	}
+
+	return nil
+}
+
+func decodeNumbers(t *testing.T, b []byte) map[string]any {
+	t.Helper()
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	v := make(map[string]any)
+	if err := dec.Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
+
+func TestSchema(t *testing.T) {
+	schema := decodeNumbers(t, config.Schema())
+	defs, _ := schema[`$defs`].(map[string]any)
+	v := &schemaValidator{defs: defs}
+
+	def, err := config.Default()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := config.Marshal(def, config.FormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.validate(``, schema, decodeNumbers(t, b)); err != nil {
+		t.Errorf("default configuration does not match schema: %v", err)
+	}
+	jsonNames, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.validate(``, schema, decodeNumbers(t, jsonNames)); err != nil {
+		t.Errorf("default configuration with JSON names does not match schema: %v", err)
+	}
+
+	invalid := []struct {
+		name string
+		data string
+	}{
+		{name: `unknown property`, data: `{"unknown": true}`},
+		{name: `invalid enum`, data: `{"log_level": "LOG_LEVEL_LOUD"}`},
+		{name: `invalid duration`, data: `{"dbus": {"connect_timeout": "20 seconds"}}`},
+		{name: `negative integer`, data: `{"panels": [{"size": -1}]}`},
+		{name: `multiple module kinds`, data: `{"panels": [{"modules": [{"pager": {}, "clock": {}}]}]}`},
+		{name: `no module kind`, data: `{"panels": [{"modules": [{}]}]}`},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := v.validate(``, schema, decodeNumbers(t, []byte(tt.data))); err == nil {
+				t.Error(`expected schema violation`)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
+)
+
+// FieldError describes a validation failure for a specific configuration field.
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+// Error implements error.
+func (e *FieldError) Error() string {
+	return e.Path + `: ` + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+func fieldError(path string, format string, args ...any) error {
+	return &FieldError{Path: path, Err: fmt.Errorf(format, args...)}
+}
+
+// Validate performs semantic validation of the configuration, and returns every problem found.
+func Validate(cfg *configv1.Config) []error {
+	var errs []error
+	if cfg == nil {
+		return []error{errors.New(`configuration is empty`)}
+	}
+
+	dbusEnabled := cfg.Dbus != nil && cfg.Dbus.Enabled
+	if dbusEnabled && cfg.Dbus.ConnectTimeout.AsDuration() <= 0 {
+		errs = append(errs, fieldError(`dbus.connect_timeout`, `must be greater than zero when dbus is enabled`))
+	}
+	if dbusEnabled && cfg.Dbus.Power != nil && cfg.Dbus.Power.Enabled {
+		if cfg.Dbus.Power.CriticalPercent > cfg.Dbus.Power.LowPercent {
+			errs = append(errs, fieldError(`dbus.power.critical_percent`, `must not exceed dbus.power.low_percent (%d)`, cfg.Dbus.Power.LowPercent))
+		}
+		if cfg.Dbus.Power.LowPercent > 100 {
+			errs = append(errs, fieldError(`dbus.power.low_percent`, `must not exceed 100`))
+		}
+	}
+	if dbusEnabled && cfg.Dbus.Brightness != nil && cfg.Dbus.Brightness.Enabled && cfg.Dbus.Brightness.AdjustStepPercent > 100 {
+		errs = append(errs, fieldError(`dbus.brightness.adjust_step_percent`, `must not exceed 100`))
+	}
+
+	if len(cfg.LaunchWrapper) > 0 && strings.TrimSpace(cfg.LaunchWrapper[0]) == `` {
+		errs = append(errs, fieldError(`launch_wrapper[0]`, `command must not be empty`))
+	}
+
+	if cfg.Supervisor != nil {
+		restart, maxRestart := cfg.Supervisor.RestartDelay.AsDuration(), cfg.Supervisor.MaxRestartDelay.AsDuration()
+		if restart < 0 {
+			errs = append(errs, fieldError(`supervisor.restart_delay`, `must not be negative`))
+		}
+		if maxRestart > 0 && restart > maxRestart {
+			errs = append(errs, fieldError(`supervisor.max_restart_delay`, `must not be less than supervisor.restart_delay (%s)`, restart))
+		}
+	}
+
+	for i, override := range cfg.IconOverrides {
+		path := fmt.Sprintf("icon_overrides[%d]", i)
+		if override.WindowClass == `` {
+			errs = append(errs, fieldError(path+`.window_class`, `must not be empty`))
+		}
+		if override.Icon == `` {
+			errs = append(errs, fieldError(path+`.icon`, `must not be empty`))
+		}
+	}
+
+	if len(cfg.Panels) == 0 {
+		errs = append(errs, fieldError(`panels`, `at least one panel must be configured`))
+	}
+
+	ids := make(map[string]int, len(cfg.Panels))
+	for i, panel := range cfg.Panels {
+		path := fmt.Sprintf("panels[%d]", i)
+		if panel.Id == `` {
+			errs = append(errs, fieldError(path+`.id`, `must not be empty`))
+		} else if strings.Contains(panel.Id, InstanceSeparator) {
+			errs = append(errs, fieldError(path+`.id`, `must not contain %q`, InstanceSeparator))
+		} else if prev, ok := ids[panel.Id]; ok {
+			errs = append(errs, fieldError(path+`.id`, `duplicate panel id %q, already used by panels[%d]`, panel.Id, prev))
+		} else {
+			ids[panel.Id] = i
+		}
+		if panel.Edge == configv1.Edge_EDGE_UNSPECIFIED {
+			errs = append(errs, fieldError(path+`.edge`, `must be specified`))
+		}
+		if panel.Size == 0 {
+			errs = append(errs, fieldError(path+`.size`, `must be greater than zero`))
+		}
+		if len(panel.Modules) == 0 {
+			errs = append(errs, fieldError(path+`.modules`, `at least one module must be configured`))
+		}
+		if panel.Monitor != `` && len(panel.Monitors) > 0 {
+			errs = append(errs, fieldError(path+`.monitors`, `must not be combined with monitor`))
+		}
+		for j, pattern := range panel.Monitors {
+			if pattern == `` {
+				errs = append(errs, fieldError(fmt.Sprintf("%s.monitors[%d]", path, j), `must not be empty`))
+			} else if err := validPattern(pattern); err != nil {
+				errs = append(errs, fieldError(fmt.Sprintf("%s.monitors[%d]", path, j), `invalid pattern %q: %w`, pattern, err))
+			}
+		}
+		if isPattern(panel.Monitor) {
+			if err := validPattern(panel.Monitor); err != nil {
+				errs = append(errs, fieldError(path+`.monitor`, `invalid pattern %q: %w`, panel.Monitor, err))
+			}
+		}
+
+		for j, mod := range panel.Modules {
+			errs = append(errs, validateModule(cfg, fmt.Sprintf("%s.modules[%d]", path, j), mod)...)
+		}
+	}
+
+	return errs
+}
+
+// ValidateMonitors reports panels that reference monitors not present in the
+// supplied list of monitor names. Template panels are not checked, as they
+// follow the connected monitors.
+func ValidateMonitors(cfg *configv1.Config, monitors []string) []error {
+	var errs []error
+	known := make(map[string]struct{}, len(monitors))
+	for _, name := range monitors {
+		known[name] = struct{}{}
+	}
+
+	for i, panel := range cfg.Panels {
+		if panel.Monitor == `` || IsTemplate(panel) {
+			continue
+		}
+		if _, ok := known[panel.Monitor]; !ok {
+			errs = append(errs, fieldError(fmt.Sprintf("panels[%d].monitor", i), `unknown monitor %q, available monitors: %s`, panel.Monitor, strings.Join(monitors, `, `)))
+		}
+	}
+
+	return errs
+}
+
+func validateModule(cfg *configv1.Config, path string, mod *modulev1.Module) []error {
+	var errs []error
+	dbus := cfg.Dbus
+	dbusEnabled := dbus != nil && dbus.Enabled
+	audioEnabled := cfg.Audio != nil && cfg.Audio.Enabled
+	powerEnabled := dbusEnabled && dbus.Power != nil && dbus.Power.Enabled
+
+	switch kind := mod.Kind.(type) {
+	case nil:
+		errs = append(errs, fieldError(path, `module type must be specified`))
+	case *modulev1.Module_Pager:
+		if kind.Pager.IconSize == 0 {
+			errs = append(errs, fieldError(path+`.pager.icon_size`, `must be greater than zero`))
+		}
+	case *modulev1.Module_Taskbar:
+		if kind.Taskbar.IconSize == 0 {
+			errs = append(errs, fieldError(path+`.taskbar.icon_size`, `must be greater than zero`))
+		}
+	case *modulev1.Module_Systray:
+		if !dbusEnabled || dbus.Systray == nil || !dbus.Systray.Enabled {
+			errs = append(errs, fieldError(path+`.systray`, `requires dbus.enabled and dbus.systray.enabled`))
+		}
+		if kind.Systray.IconSize == 0 {
+			errs = append(errs, fieldError(path+`.systray.icon_size`, `must be greater than zero`))
+		}
+		for k, sub := range kind.Systray.Modules {
+			subPath := fmt.Sprintf("%s.systray.modules[%d]", path, k)
+			switch sub.Kind.(type) {
+			case nil:
+				errs = append(errs, fieldError(subPath, `module type must be specified`))
+			case *modulev1.SystrayModule_Audio:
+				if !audioEnabled {
+					errs = append(errs, fieldError(subPath+`.audio`, `requires audio.enabled`))
+				}
+			case *modulev1.SystrayModule_Power:
+				if !powerEnabled {
+					errs = append(errs, fieldError(subPath+`.power`, `requires dbus.enabled and dbus.power.enabled`))
+				}
+			}
+		}
+	case *modulev1.Module_Notifications:
+		if !dbusEnabled || dbus.Notifications == nil || !dbus.Notifications.Enabled {
+			errs = append(errs, fieldError(path+`.notifications`, `requires dbus.enabled and dbus.notifications.enabled`))
+		}
+	case *modulev1.Module_Audio:
+		if !audioEnabled {
+			errs = append(errs, fieldError(path+`.audio`, `requires audio.enabled`))
+		}
+	case *modulev1.Module_Power:
+		if !powerEnabled {
+			errs = append(errs, fieldError(path+`.power`, `requires dbus.enabled and dbus.power.enabled`))
+		}
+	case *modulev1.Module_Spacer:
+		if kind.Spacer.Size == 0 && !kind.Spacer.Expand {
+			errs = append(errs, fieldError(path+`.spacer`, `size must be greater than zero unless expand is set`))
+		}
+	}
+
+	return errs
+}
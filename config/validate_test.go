@@ -0,0 +1,124 @@
+package config_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/pdf/hyprpanel/config"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *configv1.Config)
+		want   []string
+	}{
+		{
+			name:   `default`,
+			modify: func(*configv1.Config) {},
+		},
+		{
+			name: `power thresholds`,
+			modify: func(c *configv1.Config) {
+				c.Dbus.Power.LowPercent = 101
+				c.Dbus.Power.CriticalPercent = 102
+			},
+			want: []string{`dbus.power.critical_percent`, `dbus.power.low_percent`},
+		},
+		{
+			name: `supervisor delays`,
+			modify: func(c *configv1.Config) {
+				c.Supervisor.RestartDelay = durationpb.New(-1)
+			},
+			want: []string{`supervisor.restart_delay`},
+		},
+		{
+			name: `duplicate panel id`,
+			modify: func(c *configv1.Config) {
+				c.Panels = append(c.Panels, c.Panels[0])
+			},
+			want: []string{`panels[1].id`},
+		},
+		{
+			name: `monitor with monitors`,
+			modify: func(c *configv1.Config) {
+				c.Panels[0].Monitor = `DP-1`
+				c.Panels[0].Monitors = []string{`DP-*`, `[`}
+			},
+			want: []string{`panels[0].monitors`, `panels[0].monitors[1]`},
+		},
+		{
+			name: `module requires disabled backend`,
+			modify: func(c *configv1.Config) {
+				c.Audio.Enabled = false
+			},
+			want: []string{`panels[0].modules[8].audio`},
+		},
+		{
+			name: `modules require dbus`,
+			modify: func(c *configv1.Config) {
+				c.Dbus.Enabled = false
+			},
+			want: []string{`panels[0].modules[4].systray`, `panels[0].modules[4].systray.modules[0].power`, `panels[0].modules[5].notifications`},
+		},
+		{
+			name: `spacer size`,
+			modify: func(c *configv1.Config) {
+				c.Panels[0].Modules[1] = &modulev1.Module{Kind: &modulev1.Module_Spacer{Spacer: &modulev1.Spacer{}}}
+			},
+			want: []string{`panels[0].modules[1].spacer`},
+		},
+		{
+			name: `no panels`,
+			modify: func(c *configv1.Config) {
+				c.Panels = nil
+			},
+			want: []string{`panels`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := config.Default()
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.modify(c)
+
+			var got []string
+			for _, err := range config.Validate(c) {
+				var fieldErr *config.FieldError
+				if !errors.As(err, &fieldErr) {
+					t.Fatalf("unexpected error type: %v", err)
+				}
+				got = append(got, fieldErr.Path)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got errors for %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateMonitors(t *testing.T) {
+	c, err := config.Default()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Panels[0].Monitor = `DP-2`
+	template := &configv1.Panel{Id: `template`, Monitors: []string{`HDMI-*`}}
+	c.Panels = append(c.Panels, template)
+
+	errs := config.ValidateMonitors(c, []string{`DP-1`})
+	if len(errs) != 1 {
+		t.Fatalf("got %d errors, want 1: %v", len(errs), errs)
+	}
+	var fieldErr *config.FieldError
+	if !errors.As(errs[0], &fieldErr) || fieldErr.Path != `panels[0].monitor` {
+		t.Errorf("unexpected error: %v", errs[0])
+	}
+}
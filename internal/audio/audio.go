@@ -24,6 +24,20 @@ const (
 
 var volumeMax = int32(math.Floor(float64(proto.VolumeNorm) * exceedVolumeFactor))
 
+// StateKinds are the event kinds carrying device state, which is discarded when
+// the client is closed.
+var StateKinds = []eventv1.EventKind{
+	eventv1.EventKind_EVENT_KIND_AUDIO_SINK_NEW,
+	eventv1.EventKind_EVENT_KIND_AUDIO_SINK_CHANGE,
+	eventv1.EventKind_EVENT_KIND_AUDIO_SINK_REMOVE,
+	eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_NEW,
+	eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_CHANGE,
+	eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_REMOVE,
+	eventv1.EventKind_EVENT_KIND_AUDIO_CARD_NEW,
+	eventv1.EventKind_EVENT_KIND_AUDIO_CARD_CHANGE,
+	eventv1.EventKind_EVENT_KIND_AUDIO_CARD_REMOVE,
+}
+
 // Client for pulseaudio.
 type Client struct {
 	cfg   *configv1.Config_Audio
@@ -0,0 +1,80 @@
+// Package control provides access to the hyprpanel host control socket.
+package control
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// SocketName is the file name of the control socket within the runtime directory.
+	SocketName = `control.sock`
+	runDirName = `hyprpanel`
+)
+
+// RuntimeDir returns the hyprpanel directory under XDG_RUNTIME_DIR.
+func RuntimeDir() (string, error) {
+	runDir := os.Getenv(`XDG_RUNTIME_DIR`)
+	if runDir == `` {
+		return ``, errors.New(`XDG_RUNTIME_DIR is not set`)
+	}
+
+	return filepath.Join(runDir, runDirName), nil
+}
+
+// SocketPath returns the default path of the control socket.
+func SocketPath() (string, error) {
+	dir, err := RuntimeDir()
+	if err != nil {
+		return ``, err
+	}
+
+	return filepath.Join(dir, SocketName), nil
+}
+
+// Listen creates the control socket at path, replacing any stale socket left by a previous host.
+func Listen(path string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil && !errors.Is(err, os.ErrExist) {
+		return nil, fmt.Errorf("failed creating control socket dir: %w", err)
+	}
+
+	if conn, err := net.Dial(`unix`, path); err == nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("control socket already in use: %s", path)
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed removing stale control socket: %w", err)
+	}
+
+	l, err := net.Listen(`unix`, path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		_ = l.Close()
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Dial connects to the control socket at path.
+func Dial(path string) (hyprpanelv1.ControlServiceClient, *grpc.ClientConn, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, nil, fmt.Errorf("control socket unavailable, is hyprpanel running?: %w", err)
+	}
+
+	conn, err := grpc.NewClient(`unix://`+path, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hyprpanelv1.NewControlServiceClient(conn), conn, nil
+}
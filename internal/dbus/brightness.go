@@ -304,6 +304,11 @@ func (b *brightness) watch() {
 	}
 }
 
+func (b *brightness) close() {
+	close(b.quitCh)
+	b.conn.RemoveSignal(b.signals)
+}
+
 func newBrightness(conn *dbus.Conn, logger hclog.Logger, eventCh chan *eventv1.Event, cfg *configv1.Config_DBUS_Brightness) (*brightness, error) {
 	s := &brightness{
 		conn:            conn,
@@ -5,6 +5,8 @@ import (
 	"context"
 	"embed"
 	"errors"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/godbus/dbus/v5"
@@ -12,6 +14,7 @@ import (
 	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
 	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 //go:embed interfaces
@@ -22,6 +25,29 @@ var (
 	errUnsupported      = errors.New(`unsupported`)
 )
 
+// Event kinds carrying the state of each feature, which is discarded when the
+// feature is stopped.
+var (
+	systrayKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER,
+		eventv1.EventKind_EVENT_KIND_DBUS_UNREGISTERSTATUSNOTIFIER,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATETITLE,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATETOOLTIP,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATEICON,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATEMENU,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATESTATUS,
+	}
+	notificationKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_DBUS_NOTIFICATION,
+		eventv1.EventKind_EVENT_KIND_DBUS_CLOSENOTIFICATION,
+	}
+	brightnessKinds = []eventv1.EventKind{eventv1.EventKind_EVENT_KIND_DBUS_BRIGHTNESS_CHANGE}
+	powerKinds      = []eventv1.EventKind{eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE}
+
+	// StateKinds are the event kinds carrying state from all features.
+	StateKinds = slices.Concat(systrayKinds, notificationKinds, brightnessKinds, powerKinds)
+)
+
 // Systray DBUS API, may return nil if Systray is disabled.
 type Systray interface {
 	Activate(busName string, x, y int32) error
@@ -56,20 +82,39 @@ type Client struct {
 	notifications   *notifications
 	brightness      *brightness
 	power           *power
+	mu              sync.RWMutex
 }
 
-// Systray API.
+// Systray API, returns nil if Systray is disabled.
 func (c *Client) Systray() Systray {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.snw == nil {
+		return nil
+	}
+
 	return c.snw
 }
 
-// Notification API.
+// Notification API, returns nil if Notifications are disabled.
 func (c *Client) Notification() Notification {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.notifications == nil {
+		return nil
+	}
+
 	return c.notifications
 }
 
-// Brightness API.
+// Brightness API, returns nil if Brightness is disabled.
 func (c *Client) Brightness() Brightness {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.brightness == nil {
+		return nil
+	}
+
 	return c.brightness
 }
 
@@ -80,23 +125,159 @@ func (c *Client) Events() <-chan *eventv1.Event {
 
 // Close the client.
 func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	close(c.quitCh)
-	if c.snw != nil {
-		if err := c.snw.close(); err != nil {
-			c.log.Warn(`Failed closing SNW session`, `err`, err)
+	c.closeSystray()
+	c.closeNotifications()
+	c.closeShortcuts()
+	c.closeBrightness()
+	c.closePower()
+
+	return c.sessionConn.Close()
+}
+
+// Reconfigure applies cfg to the running client, restarting only the features
+// whose configuration changed. Connection settings are not applied until the
+// client is replaced. Returns the event kinds whose state was discarded by
+// stopping features, which is resent by any restarted feature.
+//
+// Features send events while starting, so the lock is not held while they are
+// created, in case the event channel is full.
+func (c *Client) Reconfigure(cfg *configv1.Config_DBUS) ([]eventv1.EventKind, error) {
+	c.mu.Lock()
+	prev := c.cfg
+	c.cfg = cfg
+	c.mu.Unlock()
+
+	var discarded []eventv1.EventKind
+	if !proto.Equal(prev.Notifications, cfg.Notifications) {
+		c.log.Info(`DBUS notifications configuration changed, restarting`)
+		c.mu.Lock()
+		c.closeNotifications()
+		c.mu.Unlock()
+		discarded = append(discarded, notificationKinds...)
+		if cfg.Notifications.Enabled {
+			n, err := newNotifications(c.sessionConn, c.log, c.eventCh)
+			if err != nil {
+				return discarded, err
+			}
+			c.mu.Lock()
+			c.notifications = n
+			c.mu.Unlock()
 		}
 	}
-	if c.notifications != nil {
-		if err := c.notifications.close(); err != nil {
-			c.log.Warn(`Failed closing Notifications session`, `err`, err)
+	if !proto.Equal(prev.Systray, cfg.Systray) {
+		c.log.Info(`DBUS systray configuration changed, restarting`)
+		c.mu.Lock()
+		c.closeSystray()
+		c.mu.Unlock()
+		discarded = append(discarded, systrayKinds...)
+		if cfg.Systray.Enabled {
+			snw, err := newStatusNotifierWatcher(c.sessionConn, c.log, c.eventCh)
+			if err != nil {
+				return discarded, err
+			}
+			c.mu.Lock()
+			c.snw = snw
+			c.mu.Unlock()
 		}
 	}
-	if c.globalShortcuts != nil {
-		if err := c.globalShortcuts.close(); err != nil {
-			c.log.Warn(`Failed closing GlobalShortcuts session`, `err`, err)
+	if !proto.Equal(prev.Shortcuts, cfg.Shortcuts) {
+		c.log.Info(`DBUS shortcuts configuration changed, restarting`)
+		c.mu.Lock()
+		c.closeShortcuts()
+		c.mu.Unlock()
+		if cfg.Shortcuts.Enabled {
+			gs, err := newGlobalShortcuts(c.sessionConn, c.log, c.eventCh)
+			if err != nil {
+				return discarded, err
+			}
+			c.mu.Lock()
+			c.globalShortcuts = gs
+			c.mu.Unlock()
 		}
 	}
-	return c.sessionConn.Close()
+	if !proto.Equal(prev.Brightness, cfg.Brightness) {
+		c.log.Info(`DBUS brightness configuration changed, restarting`)
+		c.mu.Lock()
+		c.closeBrightness()
+		c.mu.Unlock()
+		discarded = append(discarded, brightnessKinds...)
+		if cfg.Brightness.Enabled {
+			b, err := newBrightness(c.systemConn, c.log, c.eventCh, cfg.Brightness)
+			if err != nil {
+				return discarded, err
+			}
+			c.mu.Lock()
+			c.brightness = b
+			c.mu.Unlock()
+		}
+	}
+	if !proto.Equal(prev.Power, cfg.Power) {
+		c.log.Info(`DBUS power configuration changed, restarting`)
+		c.mu.Lock()
+		c.closePower()
+		c.mu.Unlock()
+		discarded = append(discarded, powerKinds...)
+		if cfg.Power.Enabled {
+			p, err := newPower(c.systemConn, c.log, c.eventCh, cfg.Power)
+			if err != nil {
+				return discarded, err
+			}
+			c.mu.Lock()
+			c.power = p
+			c.mu.Unlock()
+		}
+	}
+
+	return discarded, nil
+}
+
+func (c *Client) closeSystray() {
+	if c.snw == nil {
+		return
+	}
+	if err := c.snw.close(); err != nil {
+		c.log.Warn(`Failed closing SNW session`, `err`, err)
+	}
+	c.snw = nil
+}
+
+func (c *Client) closeNotifications() {
+	if c.notifications == nil {
+		return
+	}
+	if err := c.notifications.close(); err != nil {
+		c.log.Warn(`Failed closing Notifications session`, `err`, err)
+	}
+	c.notifications = nil
+}
+
+func (c *Client) closeShortcuts() {
+	if c.globalShortcuts == nil {
+		return
+	}
+	if err := c.globalShortcuts.close(); err != nil {
+		c.log.Warn(`Failed closing GlobalShortcuts session`, `err`, err)
+	}
+	c.globalShortcuts = nil
+}
+
+func (c *Client) closeBrightness() {
+	if c.brightness == nil {
+		return
+	}
+	c.brightness.close()
+	c.brightness = nil
+}
+
+func (c *Client) closePower() {
+	if c.power == nil {
+		return
+	}
+	c.power.close()
+	c.power = nil
 }
 
 func (c *Client) init() error {
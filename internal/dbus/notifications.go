@@ -219,13 +219,13 @@ func (n *notifications) Action(id uint32, actionKey string) error {
 }
 
 func (n *notifications) close() error {
-	reply, err := n.conn.ReleaseName(snwName)
+	reply, err := n.conn.ReleaseName(notificationsName)
 	if err != nil {
 		return err
 	}
 
 	if reply != dbus.ReleaseNameReplyReleased {
-		return fmt.Errorf(`unable to release SNW ownership`)
+		return fmt.Errorf(`unable to release Notifications ownership`)
 	}
 
 	return nil
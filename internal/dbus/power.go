@@ -355,6 +355,11 @@ func (p *power) watch() {
 	}
 }
 
+func (p *power) close() {
+	close(p.quitCh)
+	p.conn.RemoveSignal(p.signals)
+}
+
 func newPower(conn *dbus.Conn, logger hclog.Logger, eventCh chan *eventv1.Event, cfg *configv1.Config_DBUS_Power) (*power, error) {
 	s := &power{
 		conn:       conn,
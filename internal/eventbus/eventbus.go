@@ -0,0 +1,308 @@
+// Package eventbus provides non-blocking event fan-out, with a bounded queue per subscriber
+package eventbus
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+)
+
+// DefaultQueueSize is the queue size for subscriptions that do not specify one.
+const DefaultQueueSize = 64
+
+// Policy determines how a subscription handles a full queue.
+type Policy int
+
+const (
+	// PolicyDropOldest discards the oldest queued event to make room for the new event.
+	PolicyDropOldest Policy = iota
+	// PolicyCoalesce discards the most recent queued event of the same kind, and
+	// queues the new event at the tail, so that delivery order is preserved.
+	// Falls back to PolicyDropOldest if there is no queued event of the kind.
+	PolicyCoalesce
+)
+
+// String implements fmt.Stringer.
+func (p Policy) String() string {
+	switch p {
+	case PolicyDropOldest:
+		return `drop-oldest`
+	case PolicyCoalesce:
+		return `coalesce`
+	default:
+		return `unknown`
+	}
+}
+
+// Option configures a subscription.
+type Option func(*Subscription)
+
+// WithName sets the subscription name, used for identification in Stats.
+func WithName(name string) Option {
+	return func(s *Subscription) {
+		s.name = name
+	}
+}
+
+// WithQueueSize sets the maximum number of events queued for the subscriber.
+func WithQueueSize(size int) Option {
+	return func(s *Subscription) {
+		if size > 0 {
+			s.size = size
+		}
+	}
+}
+
+// WithPolicy sets the overflow policy for the subscription.
+func WithPolicy(policy Policy) Option {
+	return func(s *Subscription) {
+		s.policy = policy
+	}
+}
+
+// WithKinds restricts the subscription to events of the specified kinds. No
+// events are delivered if no kinds are specified.
+func WithKinds(kinds ...eventv1.EventKind) Option {
+	return func(s *Subscription) {
+		s.kinds = make(map[eventv1.EventKind]struct{}, len(kinds))
+		for _, kind := range kinds {
+			s.kinds[kind] = struct{}{}
+		}
+	}
+}
+
+// Stats describes the state of a subscription.
+type Stats struct {
+	Name    string
+	Policy  Policy
+	Queued  int
+	Dropped uint64
+}
+
+// Bus delivers published events to all matching subscriptions. Publishing
+// never blocks, each subscriber consumes events from its own queue.
+type Bus struct {
+	subs   []*Subscription
+	closed bool
+	mu     sync.RWMutex
+}
+
+// Subscribe adds a new subscription to the bus.
+func (b *Bus) Subscribe(opts ...Option) *Subscription {
+	s := &Subscription{
+		bus:    b,
+		size:   DefaultQueueSize,
+		ch:     make(chan *eventv1.Event),
+		wakeCh: make(chan struct{}, 1),
+		quitCh: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.queue = make([]*eventv1.Event, 0, s.size)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(s.quitCh)
+		close(s.ch)
+		return s
+	}
+	b.subs = append(b.subs, s)
+	go s.deliver()
+
+	return s
+}
+
+// Publish enqueues the event for all matching subscriptions.
+func (b *Bus) Publish(evt *eventv1.Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, s := range b.subs {
+		s.enqueue(evt)
+	}
+}
+
+// Stats returns the current state of all subscriptions.
+func (b *Bus) Stats() []Stats {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	stats := make([]Stats, len(b.subs))
+	for i, s := range b.subs {
+		stats[i] = s.Stats()
+	}
+
+	return stats
+}
+
+// Close closes all subscriptions, and rejects new subscriptions.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	subs := b.subs
+	b.subs = nil
+	b.closed = true
+	b.mu.Unlock()
+
+	for _, s := range subs {
+		s.stop()
+	}
+}
+
+func (b *Bus) remove(s *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs = slices.DeleteFunc(b.subs, func(sub *Subscription) bool {
+		return sub == s
+	})
+}
+
+// New instantiates a new Bus.
+func New() *Bus {
+	return &Bus{}
+}
+
+// Subscription receives events from a Bus.
+type Subscription struct {
+	bus      *Bus
+	name     string
+	size     int
+	policy   Policy
+	kinds    map[eventv1.EventKind]struct{}
+	queue    []*eventv1.Event
+	dropped  atomic.Uint64
+	ch       chan *eventv1.Event
+	wakeCh   chan struct{}
+	quitCh   chan struct{}
+	stopOnce sync.Once
+	mu       sync.Mutex
+}
+
+// Events returns the channel that events are delivered on, the channel is
+// closed when the subscription is closed.
+func (s *Subscription) Events() <-chan *eventv1.Event {
+	return s.ch
+}
+
+// Dropped returns the number of events discarded due to queue overflow.
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Stats returns the current state of the subscription.
+func (s *Subscription) Stats() Stats {
+	s.mu.Lock()
+	queued := len(s.queue)
+	s.mu.Unlock()
+
+	return Stats{
+		Name:    s.name,
+		Policy:  s.policy,
+		Queued:  queued,
+		Dropped: s.Dropped(),
+	}
+}
+
+// Forward delivers events to ch until the subscription is closed, or quitCh
+// is closed. Blocks until complete.
+func (s *Subscription) Forward(ch chan<- *eventv1.Event, quitCh <-chan struct{}) {
+	for {
+		select {
+		case <-quitCh:
+			return
+		case evt, ok := <-s.ch:
+			if !ok {
+				return
+			}
+			select {
+			case ch <- evt:
+			case <-quitCh:
+				return
+			}
+		}
+	}
+}
+
+// Close removes the subscription from the bus, and closes the events channel.
+func (s *Subscription) Close() {
+	s.bus.remove(s)
+	s.stop()
+}
+
+func (s *Subscription) stop() {
+	s.stopOnce.Do(func() {
+		close(s.quitCh)
+	})
+}
+
+func (s *Subscription) enqueue(evt *eventv1.Event) {
+	if s.kinds != nil {
+		if _, ok := s.kinds[evt.Kind]; !ok {
+			return
+		}
+	}
+
+	s.mu.Lock()
+	if len(s.queue) >= s.size {
+		s.dropped.Add(1)
+		idx := -1
+		if s.policy == PolicyCoalesce {
+			for i := len(s.queue) - 1; i >= 0; i-- {
+				if s.queue[i].Kind == evt.Kind {
+					idx = i
+					break
+				}
+			}
+		}
+		if idx >= 0 {
+			s.queue = append(slices.Delete(s.queue, idx, idx+1), evt)
+		} else {
+			s.queue = append(s.queue[1:], evt)
+		}
+	} else {
+		s.queue = append(s.queue, evt)
+	}
+	s.mu.Unlock()
+
+	select {
+	case s.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Subscription) next() (*eventv1.Event, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.queue) == 0 {
+		return nil, false
+	}
+	evt := s.queue[0]
+	s.queue[0] = nil
+	s.queue = s.queue[1:]
+
+	return evt, true
+}
+
+func (s *Subscription) deliver() {
+	defer close(s.ch)
+	for {
+		select {
+		case <-s.quitCh:
+			return
+		case <-s.wakeCh:
+		}
+
+		for {
+			evt, ok := s.next()
+			if !ok {
+				break
+			}
+			select {
+			case s.ch <- evt:
+			case <-s.quitCh:
+				return
+			}
+		}
+	}
+}
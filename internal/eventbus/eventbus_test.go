@@ -0,0 +1,184 @@
+package eventbus
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+)
+
+const (
+	kindA = eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACE
+	kindB = eventv1.EventKind_EVENT_KIND_HYPR_OPENWINDOW
+	kindC = eventv1.EventKind_EVENT_KIND_HYPR_CLOSEWINDOW
+	kindD = eventv1.EventKind_EVENT_KIND_HYPR_PIN
+)
+
+const testTimeout = 2 * time.Second
+
+func newEvent(t *testing.T, kind eventv1.EventKind, value string) *eventv1.Event {
+	t.Helper()
+	evt, err := eventv1.NewString(kind, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return evt
+}
+
+func value(t *testing.T, evt *eventv1.Event) string {
+	t.Helper()
+	v, err := eventv1.DataString(evt.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
+
+// waitQueued waits until the subscription has n queued events.
+func waitQueued(t *testing.T, sub *Subscription, n int) {
+	t.Helper()
+	deadline := time.Now().Add(testTimeout)
+	for sub.Stats().Queued != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %d queued events, have %d", n, sub.Stats().Queued)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func receive(t *testing.T, sub *Subscription, n int) []string {
+	t.Helper()
+	values := make([]string, 0, n)
+	for range n {
+		select {
+		case evt := <-sub.Events():
+			values = append(values, value(t, evt))
+		case <-time.After(testTimeout):
+			t.Fatalf("timed out waiting for event, received %v", values)
+		}
+	}
+
+	return values
+}
+
+func TestOverflow(t *testing.T) {
+	type publish struct {
+		kind  eventv1.EventKind
+		value string
+	}
+	tests := []struct {
+		name        string
+		policy      Policy
+		publish     []publish
+		want        []string
+		wantDropped uint64
+	}{
+		{
+			name:        `drop oldest`,
+			policy:      PolicyDropOldest,
+			publish:     []publish{{kindA, `a1`}, {kindB, `b1`}, {kindA, `a2`}, {kindB, `b2`}},
+			want:        []string{`b1`, `a2`, `b2`},
+			wantDropped: 1,
+		},
+		{
+			name:        `drop oldest preserves order`,
+			policy:      PolicyDropOldest,
+			publish:     []publish{{kindA, `a1`}, {kindA, `a2`}, {kindA, `a3`}, {kindA, `a4`}, {kindA, `a5`}},
+			want:        []string{`a3`, `a4`, `a5`},
+			wantDropped: 2,
+		},
+		{
+			name:        `coalesce queues at tail`,
+			policy:      PolicyCoalesce,
+			publish:     []publish{{kindA, `a1`}, {kindB, `b1`}, {kindC, `c1`}, {kindA, `a2`}},
+			want:        []string{`b1`, `c1`, `a2`},
+			wantDropped: 1,
+		},
+		{
+			name:        `coalesce most recent of kind`,
+			policy:      PolicyCoalesce,
+			publish:     []publish{{kindA, `a1`}, {kindB, `b1`}, {kindA, `a2`}, {kindB, `b2`}},
+			want:        []string{`a1`, `a2`, `b2`},
+			wantDropped: 1,
+		},
+		{
+			name:        `coalesce falls back to drop oldest`,
+			policy:      PolicyCoalesce,
+			publish:     []publish{{kindA, `a1`}, {kindB, `b1`}, {kindC, `c1`}, {kindD, `d1`}},
+			want:        []string{`b1`, `c1`, `d1`},
+			wantDropped: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := New()
+			defer bus.Close()
+			sub := bus.Subscribe(WithQueueSize(3), WithPolicy(tt.policy))
+			defer sub.Close()
+
+			// The first event is held by the delivery goroutine until it is
+			// received, so that the following events fill the queue.
+			bus.Publish(newEvent(t, kindA, `first`))
+			waitQueued(t, sub, 0)
+			for _, p := range tt.publish {
+				bus.Publish(newEvent(t, p.kind, p.value))
+			}
+
+			got := receive(t, sub, len(tt.want)+1)
+			if want := append([]string{`first`}, tt.want...); !slices.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+			if dropped := sub.Dropped(); dropped != tt.wantDropped {
+				t.Errorf("got %d dropped, want %d", dropped, tt.wantDropped)
+			}
+		})
+	}
+}
+
+func TestKinds(t *testing.T) {
+	bus := New()
+	defer bus.Close()
+	all := bus.Subscribe()
+	defer all.Close()
+	filtered := bus.Subscribe(WithKinds(kindB))
+	defer filtered.Close()
+	none := bus.Subscribe(WithKinds())
+	defer none.Close()
+
+	bus.Publish(newEvent(t, kindA, `a1`))
+	bus.Publish(newEvent(t, kindB, `b1`))
+
+	if got := receive(t, all, 2); !slices.Equal(got, []string{`a1`, `b1`}) {
+		t.Errorf("unfiltered: got %v", got)
+	}
+	if got := receive(t, filtered, 1); !slices.Equal(got, []string{`b1`}) {
+		t.Errorf("filtered: got %v", got)
+	}
+	select {
+	case evt := <-none.Events():
+		t.Errorf("subscription without kinds received %v", evt)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestClose(t *testing.T) {
+	bus := New()
+	sub := bus.Subscribe()
+	bus.Close()
+
+	select {
+	case _, ok := <-sub.Events():
+		if ok {
+			t.Error(`received event after close`)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal(`events channel not closed`)
+	}
+	if _, ok := <-bus.Subscribe().Events(); ok {
+		t.Error(`subscription after close received event`)
+	}
+}
@@ -0,0 +1,113 @@
+package hypripc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	batchPrefix = `[[BATCH]]`
+	// batchDelimiter separates the responses to each request in a batch.
+	batchDelimiter = "\n\n\n"
+)
+
+type batchRequest struct {
+	args []string
+	dst  any
+}
+
+// Batch collects queries and commands to send to Hyprland in a single
+// round-trip. Results are decoded into the supplied destinations when the
+// batch is run.
+type Batch struct {
+	h        *HyprIPC
+	requests []batchRequest
+}
+
+// Batch returns a new, empty batch.
+func (h *HyprIPC) Batch() *Batch {
+	return &Batch{h: h}
+}
+
+// Query adds a query, decoding the JSON result into dst.
+func (b *Batch) Query(dst any, args ...string) *Batch {
+	b.requests = append(b.requests, batchRequest{args: args, dst: dst})
+	return b
+}
+
+// ActiveWindow adds a query for the currently active window client.
+func (b *Batch) ActiveWindow(dst *Client) *Batch {
+	return b.Query(dst, `activewindow`)
+}
+
+// ActiveWorkspace adds a query for the currently active workspace.
+func (b *Batch) ActiveWorkspace(dst *Workspace) *Batch {
+	return b.Query(dst, `activeworkspace`)
+}
+
+// Clients adds a query for all active client windows.
+func (b *Batch) Clients(dst *[]Client) *Batch {
+	return b.Query(dst, `clients`)
+}
+
+// Monitors adds a query for all monitors.
+func (b *Batch) Monitors(dst *[]Monitor) *Batch {
+	return b.Query(dst, `monitors all`)
+}
+
+// Workspaces adds a query for all active workspaces.
+func (b *Batch) Workspaces(dst *[]Workspace) *Batch {
+	return b.Query(dst, `workspaces`)
+}
+
+// Dispatch adds a dispatcher call.
+func (b *Batch) Dispatch(args ...string) *Batch {
+	b.requests = append(b.requests, batchRequest{args: append([]string{`dispatch`}, args...)})
+	return b
+}
+
+// Keyword adds a runtime config option change.
+func (b *Batch) Keyword(name, value string) *Batch {
+	b.requests = append(b.requests, batchRequest{args: []string{`keyword`, name, value}})
+	return b
+}
+
+// Run sends the batch, and decodes the results. Dispatch and Keyword requests
+// that do not succeed are reported as errors, after all results are decoded.
+func (b *Batch) Run() error {
+	if len(b.requests) == 0 {
+		return nil
+	}
+
+	cmds := make([]string, len(b.requests))
+	for i, req := range b.requests {
+		cmds[i] = `j/` + strings.Join(req.args, ` `)
+	}
+	res, err := b.h.request(batchPrefix + strings.Join(cmds, `;`))
+	if err != nil {
+		return err
+	}
+
+	replies := bytes.Split(res, []byte(batchDelimiter))
+	if len(replies) != len(b.requests) {
+		return fmt.Errorf("invalid batch response: expected %d replies, got %d", len(b.requests), len(replies))
+	}
+
+	var errs []error
+	for i, req := range b.requests {
+		if req.dst == nil {
+			if reply := bytes.TrimSpace(replies[i]); !bytes.Equal(reply, []byte(`ok`)) {
+				errs = append(errs, fmt.Errorf("hyprland %s failed: %s", req.args[0], reply))
+			}
+			continue
+		}
+		if err := json.Unmarshal(replies[i], req.dst); err != nil {
+			errs = append(errs, fmt.Errorf("invalid batch response (%s): %w", req.args[0], err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
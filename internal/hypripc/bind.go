@@ -0,0 +1,20 @@
+package hypripc
+
+// Bind key or mouse binding.
+type Bind struct {
+	Locked         bool   `json:"locked"`
+	Mouse          bool   `json:"mouse"`
+	Release        bool   `json:"release"`
+	Repeat         bool   `json:"repeat"`
+	LongPress      bool   `json:"longPress"`
+	NonConsuming   bool   `json:"non_consuming"`
+	HasDescription bool   `json:"has_description"`
+	Modmask        int    `json:"modmask"`
+	Submap         string `json:"submap"`
+	Key            string `json:"key"`
+	Keycode        int    `json:"keycode"`
+	CatchAll       bool   `json:"catch_all"`
+	Description    string `json:"description"`
+	Dispatcher     string `json:"dispatcher"`
+	Arg            string `json:"arg"`
+}
@@ -0,0 +1,139 @@
+package hypripc
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+// MinVersion is the minimum supported Hyprland version.
+const MinVersion = `0.42.0`
+
+// Capability identifies a Hyprland feature that is not available in all versions.
+type Capability string
+
+const (
+	// CapabilityWorkspaceV2 workspacev2, createworkspacev2, destroyworkspacev2 and moveworkspacev2 events.
+	CapabilityWorkspaceV2 Capability = `workspacev2`
+	// CapabilityActiveWindowV2 activewindowv2 event.
+	CapabilityActiveWindowV2 Capability = `activewindowv2`
+	// CapabilityMoveWindowV2 movewindowv2 event.
+	CapabilityMoveWindowV2 Capability = `movewindowv2`
+	// CapabilityMonitorAddedV2 monitoraddedv2 event.
+	CapabilityMonitorAddedV2 Capability = `monitoraddedv2`
+	// CapabilityBatch batched requests.
+	CapabilityBatch Capability = `batch`
+	// CapabilityToplevelExport hyprland_toplevel_export_v1 Wayland protocol.
+	CapabilityToplevelExport Capability = `toplevel-export`
+	// CapabilityWindowTitleV2 windowtitlev2 event.
+	CapabilityWindowTitleV2 Capability = `windowtitlev2`
+	// CapabilityActiveSpecialV2 activespecialv2 event.
+	CapabilityActiveSpecialV2 Capability = `activespecialv2`
+)
+
+// capabilityVersions holds the first version providing each capability.
+// Capabilities introduced before MinVersion are reported from MinVersion.
+var capabilityVersions = map[Capability]string{
+	CapabilityWorkspaceV2:     MinVersion,
+	CapabilityActiveWindowV2:  MinVersion,
+	CapabilityMoveWindowV2:    MinVersion,
+	CapabilityMonitorAddedV2:  MinVersion,
+	CapabilityBatch:           MinVersion,
+	CapabilityToplevelExport:  MinVersion,
+	CapabilityWindowTitleV2:   `0.45.0`,
+	CapabilityActiveSpecialV2: `0.50.0`,
+}
+
+var versionMatch = regexp.MustCompile(`^v?(\d+)\.(\d+)\.(\d+)`)
+
+type semver [3]int
+
+func (v semver) less(o semver) bool {
+	for i := range v {
+		if v[i] != o[i] {
+			return v[i] < o[i]
+		}
+	}
+
+	return false
+}
+
+func parseSemver(s string) (semver, error) {
+	m := versionMatch.FindStringSubmatch(s)
+	if m == nil {
+		return semver{}, fmt.Errorf("invalid version %q", s)
+	}
+	var v semver
+	for i := range v {
+		v[i], _ = strconv.Atoi(m[i+1])
+	}
+
+	return v, nil
+}
+
+// Capabilities is the set of features available in the running Hyprland
+// instance. When the version can not be determined, all capabilities are
+// assumed to be available.
+type Capabilities struct {
+	version string
+	known   bool
+	ver     semver
+	caps    map[Capability]bool
+	mu      sync.RWMutex
+}
+
+// Version returns the detected Hyprland version, or an empty string if unknown.
+func (c *Capabilities) Version() string {
+	return c.version
+}
+
+// Supported reports whether the Hyprland version is at least MinVersion.
+func (c *Capabilities) Supported() bool {
+	if !c.known {
+		return true
+	}
+	minVer, _ := parseSemver(MinVersion)
+
+	return !c.ver.less(minVer)
+}
+
+// Has reports whether the capability is available.
+func (c *Capabilities) Has(capability Capability) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.caps[capability]
+}
+
+// Set overrides the availability of a capability, for features detected at
+// runtime rather than by version.
+func (c *Capabilities) Set(capability Capability, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.caps[capability] = ok
+}
+
+// NewCapabilities returns the capabilities for the Hyprland version, v may be
+// nil if the version is unknown.
+func NewCapabilities(v *Version) *Capabilities {
+	c := &Capabilities{
+		caps: make(map[Capability]bool, len(capabilityVersions)),
+	}
+	if v != nil {
+		// The version field was added after the tag, which includes commit
+		// details for development builds.
+		for _, s := range []string{v.Version, v.Tag} {
+			if ver, err := parseSemver(s); err == nil {
+				c.version, c.ver, c.known = s, ver, true
+				break
+			}
+		}
+	}
+	for capability, s := range capabilityVersions {
+		ver, _ := parseSemver(s)
+		c.caps[capability] = !c.known || !c.ver.less(ver)
+	}
+
+	return c
+}
@@ -0,0 +1,48 @@
+package hypripc
+
+// Devices contains all input devices, by type.
+type Devices struct {
+	Mice      []Mouse    `json:"mice"`
+	Keyboards []Keyboard `json:"keyboards"`
+	Tablets   []Tablet   `json:"tablets"`
+	Touch     []Device   `json:"touch"`
+	Switches  []Device   `json:"switches"`
+}
+
+// Device container.
+type Device struct {
+	Address string `json:"address"`
+	Name    string `json:"name"`
+}
+
+// Mouse device.
+type Mouse struct {
+	Address      string  `json:"address"`
+	Name         string  `json:"name"`
+	DefaultSpeed float64 `json:"defaultSpeed"`
+}
+
+// Keyboard device.
+type Keyboard struct {
+	Address      string `json:"address"`
+	Name         string `json:"name"`
+	Rules        string `json:"rules"`
+	Model        string `json:"model"`
+	Layout       string `json:"layout"`
+	Variant      string `json:"variant"`
+	Options      string `json:"options"`
+	ActiveKeymap string `json:"active_keymap"`
+	CapsLock     bool   `json:"capsLock"`
+	NumLock      bool   `json:"numLock"`
+	Main         bool   `json:"main"`
+}
+
+// Tablet device, either a tablet, tablet pad or tablet tool.
+type Tablet struct {
+	Address   string `json:"address"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	BelongsTo Device `json:"belongsTo"`
+	ProductID int    `json:"productId"`
+	VendorID  int    `json:"vendorId"`
+}
@@ -0,0 +1,4 @@
+package hypripc
+
+// HyprToEvent exposes hyprToEvent to external tests.
+var HyprToEvent = hyprToEvent
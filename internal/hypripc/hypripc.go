@@ -3,6 +3,7 @@ package hypripc
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,13 +11,16 @@ import (
 	"os"
 	"path"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
-	"github.com/google/uuid"
 	"github.com/hashicorp/go-hclog"
+	"github.com/pdf/hyprpanel/internal/eventbus"
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -88,6 +92,29 @@ const (
 	EventIgnoreGroupLock = `ignoregrouplock`
 	// EventLockGroups event identifier.
 	EventLockGroups = `lockgroups`
+	// EventWindowTitleV2 event identifier.
+	EventWindowTitleV2 = `windowtitlev2`
+	// EventActiveSpecialV2 event identifier.
+	EventActiveSpecialV2 = `activespecialv2`
+	// EventPin event identifier.
+	EventPin = `pin`
+	// EventConfigReloaded event identifier.
+	EventConfigReloaded = `configreloaded`
+	// EventBell event identifier.
+	EventBell = `bell`
+	// EventToggleGroup event identifier.
+	EventToggleGroup = `togglegroup`
+	// EventMoveIntoGroup event identifier.
+	EventMoveIntoGroup = `moveintogroup`
+	// EventMoveOutOfGroup event identifier.
+	EventMoveOutOfGroup = `moveoutofgroup`
+	// EventMonitorAddedV2 event identifier.
+	EventMonitorAddedV2 = `monitoraddedv2`
+	// EventMonitorRemovedV2 event identifier.
+	EventMonitorRemovedV2 = `monitorremovedv2`
+	// EventReconnected is a synthetic event emitted after the connection to
+	// the event socket is re-established, subscribers should resync state.
+	EventReconnected = `reconnected`
 
 	// DispatchWorkspace dispatcher identifier.
 	DispatchWorkspace = `workspace`
@@ -101,6 +128,11 @@ const (
 	DispatchMoveToWorkspaceSilent = `movetoworkspacesilent`
 )
 
+const (
+	reconnectDelay    = 100 * time.Millisecond
+	maxReconnectDelay = 5 * time.Second
+)
+
 var eventMatch = regexp.MustCompile(`^(?P<Event>[^>]+)>>(?P<Value>.*)$`)
 
 // CancelFunc cancels a subscription when called.
@@ -108,12 +140,15 @@ type CancelFunc func()
 
 // HyprIPC client.
 type HyprIPC struct {
-	log           hclog.Logger
-	subscriptions map[Event]map[uuid.UUID]chan *eventv1.Event
-	evtConn       net.Conn
-	evtBus        chan []byte
-	quitCh        chan struct{}
-	mu            sync.RWMutex
+	log     hclog.Logger
+	bus     *eventbus.Bus
+	evtConn net.Conn
+	evtBus  chan []byte
+	quitCh  chan struct{}
+	caps    *Capabilities
+	offline *Snapshot
+	connMu  sync.Mutex
+	capsMu  sync.Mutex
 }
 
 // ActiveWindow returns the currently active window client.
@@ -191,13 +226,201 @@ func (h *HyprIPC) Workspaces() ([]Workspace, error) {
 	return workspaces, nil
 }
 
+// Binds returns a list of all registered binds.
+func (h *HyprIPC) Binds() ([]Bind, error) {
+	res, err := h.send(`binds`)
+	if err != nil {
+		return nil, err
+	}
+
+	binds := make([]Bind, 0)
+	if err := json.Unmarshal(res, &binds); err != nil {
+		return nil, err
+	}
+
+	return binds, nil
+}
+
+// Layers returns the layer surfaces on each monitor, keyed by monitor name.
+func (h *HyprIPC) Layers() (map[string]MonitorLayers, error) {
+	res, err := h.send(`layers`)
+	if err != nil {
+		return nil, err
+	}
+
+	layers := make(map[string]MonitorLayers)
+	if err := json.Unmarshal(res, &layers); err != nil {
+		return nil, err
+	}
+
+	return layers, nil
+}
+
+// Devices returns all input devices.
+func (h *HyprIPC) Devices() (*Devices, error) {
+	res, err := h.send(`devices`)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := &Devices{}
+	if err := json.Unmarshal(res, devices); err != nil {
+		return nil, err
+	}
+
+	return devices, nil
+}
+
+// Version returns the version of the running Hyprland instance.
+func (h *HyprIPC) Version() (*Version, error) {
+	res, err := h.send(`version`)
+	if err != nil {
+		return nil, err
+	}
+
+	version := &Version{}
+	if err := json.Unmarshal(res, version); err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// Capabilities returns the capabilities of the running Hyprland instance,
+// detected from its version. The result is cached after the first successful
+// query.
+func (h *HyprIPC) Capabilities() (*Capabilities, error) {
+	h.capsMu.Lock()
+	defer h.capsMu.Unlock()
+	if h.caps != nil {
+		return h.caps, nil
+	}
+	version, err := h.Version()
+	if err != nil {
+		return nil, err
+	}
+	h.caps = NewCapabilities(version)
+
+	return h.caps, nil
+}
+
+// GetOption returns the value of the named config option, eg `general:border_size`.
+func (h *HyprIPC) GetOption(name string) (*Option, error) {
+	res, err := h.send(`getoption`, name)
+	if err != nil {
+		return nil, err
+	}
+
+	option := &Option{}
+	if err := json.Unmarshal(res, option); err != nil {
+		return nil, fmt.Errorf("invalid option (%s): %s", name, bytes.TrimSpace(res))
+	}
+
+	return option, nil
+}
+
+// CursorPos returns the current cursor position.
+func (h *HyprIPC) CursorPos() (*CursorPos, error) {
+	res, err := h.send(`cursorpos`)
+	if err != nil {
+		return nil, err
+	}
+
+	pos := &CursorPos{}
+	if err := json.Unmarshal(res, pos); err != nil {
+		return nil, err
+	}
+
+	return pos, nil
+}
+
+// ConfigErrors returns a list of errors in the current Hyprland config.
+func (h *HyprIPC) ConfigErrors() ([]string, error) {
+	res, err := h.send(`configerrors`)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make([]string, 0)
+	if err := json.Unmarshal(res, &errs); err != nil {
+		return nil, err
+	}
+
+	// Hyprland reports a single empty error when there are none.
+	return slices.DeleteFunc(errs, func(e string) bool {
+		return e == ``
+	}), nil
+}
+
+// WorkspaceRules returns a list of all configured workspace rules.
+func (h *HyprIPC) WorkspaceRules() ([]WorkspaceRule, error) {
+	res, err := h.send(`workspacerules`)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]WorkspaceRule, 0)
+	if err := json.Unmarshal(res, &rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// Layouts returns a list of all available layouts.
+func (h *HyprIPC) Layouts() ([]string, error) {
+	res, err := h.send(`layouts`)
+	if err != nil {
+		return nil, err
+	}
+
+	layouts := make([]string, 0)
+	if err := json.Unmarshal(res, &layouts); err != nil {
+		return nil, err
+	}
+
+	return layouts, nil
+}
+
+// Keyword sets a config option at runtime, eg `general:border_size 2`.
+func (h *HyprIPC) Keyword(name, value string) error {
+	return h.command(`keyword`, name, value)
+}
+
+// SetProp sets a property on the windows matching the window selector, eg
+// `address:0x1234 alpha 0.8`.
+func (h *HyprIPC) SetProp(window, prop, value string) error {
+	return h.command(`setprop`, window, prop, value)
+}
+
 // Dispatch calls a dispatcher.
 func (h *HyprIPC) Dispatch(args ...string) error {
 	_, err := h.send(append([]string{`dispatch`}, args...)...)
 	return err
 }
 
+// command sends a request with no result, and converts an error response to
+// an error.
+func (h *HyprIPC) command(args ...string) error {
+	res, err := h.send(args...)
+	if err != nil {
+		return err
+	}
+	if res := bytes.TrimSpace(res); !bytes.Equal(res, []byte(`ok`)) {
+		return fmt.Errorf("hyprland %s failed: %s", args[0], res)
+	}
+
+	return nil
+}
+
 func (h *HyprIPC) send(args ...string) ([]byte, error) {
+	return h.request(`j/` + strings.Join(args, ` `))
+}
+
+func (h *HyprIPC) request(req string) ([]byte, error) {
+	if h.offline != nil {
+		return h.offlineReply(req)
+	}
 	sock, err := socketPath(`.socket.sock`)
 	if err != nil {
 		return nil, err
@@ -212,59 +435,47 @@ func (h *HyprIPC) send(args ...string) ([]byte, error) {
 		}
 	}()
 
-	if _, err := fmt.Fprintf(ctrl, "j/%s", strings.Join(args, ` `)); err != nil {
+	if _, err := io.WriteString(ctrl, req); err != nil {
 		return nil, err
 	}
 
 	return io.ReadAll(ctrl)
 }
 
-// Subscribe returns a channel that will emit the specified event(s) when they arrive.
-func (h *HyprIPC) Subscribe(evt ...Event) (chan *eventv1.Event, CancelFunc) {
-	id := uuid.New()
-	ch := make(chan *eventv1.Event)
-
-	h.mu.Lock()
-	defer h.mu.Unlock()
-
-	if len(evt) == 0 {
-		evt = []Event{EventUnspecified}
-	}
-
-	for _, e := range evt {
-		if _, ok := h.subscriptions[e]; !ok {
-			h.subscriptions[e] = make(map[uuid.UUID]chan *eventv1.Event, 0)
-		}
-
-		h.subscriptions[e][id] = ch
-	}
+// Subscribe returns a channel that will emit events matching the subscription
+// options when they arrive. Each subscriber has its own bounded queue, so a
+// slow subscriber does not delay other subscribers.
+func (h *HyprIPC) Subscribe(opts ...eventbus.Option) (<-chan *eventv1.Event, CancelFunc) {
+	sub := h.bus.Subscribe(opts...)
 
-	return ch, func() {
-		h.mu.Lock()
-		defer h.mu.Unlock()
-		for _, e := range evt {
-			delete(h.subscriptions[e], id)
-		}
-		close(ch)
-	}
+	return sub.Events(), sub.Close
 }
 
-// StartEvents begins the event loop.
+// StartEvents begins the event loop, offline clients have no events.
 func (h *HyprIPC) StartEvents() {
+	if h.offline != nil {
+		return
+	}
 	go h.readloop()
 	go h.eventloop()
 }
 
 // Close terminates all connections, event loops, and closes all subscriptions.
 func (h *HyprIPC) Close() {
+	h.connMu.Lock()
+	defer h.connMu.Unlock()
 	close(h.quitCh)
+	if h.offline != nil {
+		h.bus.Close()
+		return
+	}
 	if err := h.evtConn.Close(); err != nil {
 		h.log.Error(`failed closing hyprland IPC connection`, `err`, err)
 	}
-	close(h.evtBus)
 }
 
 func (h *HyprIPC) eventloop() {
+	defer h.bus.Close()
 	for line := range h.evtBus {
 		result := eventMatch.FindSubmatch(line)
 		if len(result) < 3 {
@@ -279,71 +490,141 @@ func (h *HyprIPC) eventloop() {
 			h.log.Warn(`failed parsing hyprland event`, `err`, err)
 			continue
 		}
-		h.mu.RLock()
-		if _, ok := h.subscriptions[EventUnspecified]; ok {
-			for _, ch := range h.subscriptions[EventUnspecified] {
-				select {
-				case <-h.quitCh:
-					return
-				default:
-					select {
-					case <-h.quitCh:
-						return
-					case ch <- evt:
-					}
-				}
-			}
-		}
-		if _, ok := h.subscriptions[name]; !ok {
-			h.mu.RUnlock()
-			continue
-		}
-		for _, ch := range h.subscriptions[name] {
+		h.bus.Publish(evt)
+	}
+}
+
+func (h *HyprIPC) readloop() {
+	defer close(h.evtBus)
+
+	for {
+		h.connMu.Lock()
+		conn := h.evtConn
+		h.connMu.Unlock()
+
+		scanner := bufio.NewScanner(conn)
+		scanner.Split(bufio.ScanLines)
+		for scanner.Scan() {
+			line := append([]byte(nil), scanner.Bytes()...)
 			select {
+			case h.evtBus <- line:
 			case <-h.quitCh:
 				return
-			default:
-				select {
-				case <-h.quitCh:
-					return
-				case ch <- evt:
-				}
 			}
 		}
-		h.mu.RUnlock()
+
+		select {
+		case <-h.quitCh:
+			return
+		default:
+		}
+
+		err := scanner.Err()
+		if err == nil {
+			err = io.EOF
+		}
+		h.log.Warn(`Lost connection to hyprland IPC bus, reconnecting`, `err`, err)
+		if !h.reconnect() {
+			return
+		}
+
+		select {
+		case h.evtBus <- []byte(EventReconnected + `>>`):
+		case <-h.quitCh:
+			return
+		}
 	}
 }
 
-func (h *HyprIPC) readloop() {
-	scanner := bufio.NewScanner(h.evtConn)
-	scanner.Split(bufio.ScanLines)
+// reconnect re-establishes the event socket connection with backoff,
+// resolving the socket path on each attempt, and selecting a new instance if
+// Hyprland was restarted. Returns false if the client was closed before a
+// connection could be established.
+func (h *HyprIPC) reconnect() bool {
+	delay := reconnectDelay
+	for {
+		select {
+		case <-h.quitCh:
+			return false
+		case <-time.After(delay):
+		}
+
+		conn, err := dialEvents()
+		if err != nil && h.reselectInstance() {
+			conn, err = dialEvents()
+		}
+		if err != nil {
+			h.log.Debug(`Failed reconnecting to hyprland IPC bus`, `err`, err, `delay`, delay)
+			delay = min(delay*2, maxReconnectDelay)
+			continue
+		}
+
+		h.connMu.Lock()
+		select {
+		case <-h.quitCh:
+			h.connMu.Unlock()
+			if err := conn.Close(); err != nil {
+				h.log.Error(`failed closing hyprland IPC connection`, `err`, err)
+			}
+			return false
+		default:
+		}
+		if err := h.evtConn.Close(); err != nil {
+			h.log.Debug(`failed closing hyprland IPC connection`, `err`, err)
+		}
+		h.evtConn = conn
+		h.connMu.Unlock()
 
-	for scanner.Scan() {
-		h.evtBus <- scanner.Bytes()
+		h.log.Info(`Reconnected to hyprland IPC bus`)
+		return true
 	}
+}
 
-	if scanner.Err() != nil {
-		h.log.Error(`Lost connection to hyprland IPC bus`, `err`, scanner.Err())
+// reselectInstance selects a newly discovered instance if the current
+// instance is no longer running, as Hyprland generates a new signature each
+// time it starts. The selection is exported to HYPRLAND_INSTANCE_SIGNATURE, so
+// that requests, and processes started later, use the new instance. Returns
+// true if the instance changed.
+func (h *HyprIPC) reselectInstance() bool {
+	sig := os.Getenv(`HYPRLAND_INSTANCE_SIGNATURE`)
+	if sig != `` && instanceLive(sig) {
+		return false
 	}
+	inst, err := discoverInstance()
+	if err != nil || inst.Instance == sig {
+		return false
+	}
+	if err := os.Setenv(`HYPRLAND_INSTANCE_SIGNATURE`, inst.Instance); err != nil {
+		h.log.Warn(`Failed selecting hyprland instance`, `instance`, inst.Instance, `err`, err)
+		return false
+	}
+	h.log.Info(`Hyprland instance changed`, `previous`, sig, `instance`, inst.Instance)
+
+	return true
 }
 
-// New instantiates a new HyprIPC client
-func New(log hclog.Logger) (*HyprIPC, error) {
+func dialEvents() (net.Conn, error) {
 	sock, err := socketPath(`.socket2.sock`)
 	if err != nil {
 		return nil, err
 	}
-	evtConn, err := net.Dial(`unix`, sock)
+
+	return net.Dial(`unix`, sock)
+}
+
+// New instantiates a new HyprIPC client
+func New(log hclog.Logger) (*HyprIPC, error) {
+	evtConn, err := dialEvents()
 	if err != nil {
 		return nil, err
 	}
 
 	ipc := &HyprIPC{
-		log:           log,
-		evtConn:       evtConn,
-		evtBus:        make(chan []byte, 10),
-		quitCh:        make(chan struct{}),
-		subscriptions: make(map[Event]map[uuid.UUID]chan *eventv1.Event),
+		log:     log,
+		bus:     eventbus.New(),
+		evtConn: evtConn,
+		evtBus:  make(chan []byte, 10),
+		quitCh:  make(chan struct{}),
 	}
 
 	return ipc, nil
@@ -386,10 +667,21 @@ func hyprToEvent(name Event, value string) (*eventv1.Event, error) {
 			Data: data,
 		}, nil
 	case EventActiveWindowV2:
-		value = `0x` + value
-		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_ACTIVEWINDOWV2, value)
+		// No active window is reported as an empty address.
+		var addr string
+		if value != `` && value != `,` {
+			var err error
+			if addr, err = parseAddress(name, value); err != nil {
+				return nil, err
+			}
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_ACTIVEWINDOWV2, &eventv1.HyprActiveWindowV2Value{Address: addr})
 	case EventFullscreen:
-		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_FULLSCREEN, value)
+		fullscreen, err := parseFlag(name, value)
+		if err != nil {
+			return nil, err
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_FULLSCREEN, &eventv1.HyprFullscreenValue{Fullscreen: fullscreen})
 	case EventMonitorRemoved:
 		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_MONITORREMOVED, value)
 	case EventMonitorAdded:
@@ -525,12 +817,16 @@ func hyprToEvent(name Event, value string) (*eventv1.Event, error) {
 		if len(s) != 3 {
 			return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_MOVEWINDOWV2, value)
 		}
+		addr, err := parseAddress(name, s[0])
+		if err != nil {
+			return nil, err
+		}
 		id, err := strconv.Atoi(s[1])
 		if err != nil {
-			return nil, fmt.Errorf("invalid event (%s): %w", EventRenameWorkspace, err)
+			return nil, fmt.Errorf("invalid event (%s): %w", EventMoveWindowV2, err)
 		}
 		data, err := anypb.New(&eventv1.HyprMoveWindowV2Value{
-			Address:       s[0],
+			Address:       addr,
 			WorkspaceId:   int32(id),
 			WorkspaceName: s[2],
 		})
@@ -542,30 +838,225 @@ func hyprToEvent(name Event, value string) (*eventv1.Event, error) {
 			Data: data,
 		}, nil
 	case EventOpenLayer:
-		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_OPENLAYER, value)
+		if value == `` {
+			return nil, fmt.Errorf("invalid event (%s): missing namespace", name)
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_OPENLAYER, &eventv1.HyprOpenLayerValue{Namespace: value})
 	case EventCloseLayer:
-		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_CLOSELAYER, value)
+		if value == `` {
+			return nil, fmt.Errorf("invalid event (%s): missing namespace", name)
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_CLOSELAYER, &eventv1.HyprCloseLayerValue{Namespace: value})
 	case EventSubmap:
-		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_SUBMAP, value)
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_SUBMAP, &eventv1.HyprSubmapValue{Name: value})
 	case EventChangeFloatingMode:
-		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_CHANGEFLOATINGMODE, value)
+		s, err := splitValue(name, value, 2)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := parseAddress(name, s[0])
+		if err != nil {
+			return nil, err
+		}
+		floating, err := parseFlag(name, s[1])
+		if err != nil {
+			return nil, err
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_CHANGEFLOATINGMODE, &eventv1.HyprChangeFloatingModeValue{Address: addr, Floating: floating})
 	case EventUrgent:
-		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_URGENT, value)
+		addr, err := parseAddress(name, value)
+		if err != nil {
+			return nil, err
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_URGENT, &eventv1.HyprUrgentValue{Address: addr})
 	case EventMinimize:
-		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_MINIMIZE, value)
+		s, err := splitValue(name, value, 2)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := parseAddress(name, s[0])
+		if err != nil {
+			return nil, err
+		}
+		minimized, err := parseFlag(name, s[1])
+		if err != nil {
+			return nil, err
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_MINIMIZE, &eventv1.HyprMinimizeValue{Address: addr, Minimized: minimized})
 	case EventScreencast:
-		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_SCREENCAST, value)
+		s, err := splitValue(name, value, 2)
+		if err != nil {
+			return nil, err
+		}
+		active, err := parseFlag(name, s[0])
+		if err != nil {
+			return nil, err
+		}
+		windowShare, err := parseFlag(name, s[1])
+		if err != nil {
+			return nil, err
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_SCREENCAST, &eventv1.HyprScreencastValue{Active: active, WindowShare: windowShare})
 	case EventWindowTitle:
-		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_WINDOWTITLE, value)
+		addr, err := parseAddress(name, value)
+		if err != nil {
+			return nil, err
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_WINDOWTITLE, &eventv1.HyprWindowTitleValue{Address: addr})
 	case EventIgnoreGroupLock:
 		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_IGNOREGROUPLOCK, value)
 	case EventLockGroups:
 		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_EVENTLOCKGROUPS, value)
+	case EventWindowTitleV2:
+		s, err := splitValue(name, value, 2)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := parseAddress(name, s[0])
+		if err != nil {
+			return nil, err
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_WINDOWTITLEV2, &eventv1.HyprWindowTitleV2Value{Address: addr, Title: s[1]})
+	case EventActiveSpecialV2:
+		s, err := splitValue(name, value, 3)
+		if err != nil {
+			return nil, err
+		}
+		var id int
+		if s[0] != `` {
+			if id, err = strconv.Atoi(s[0]); err != nil {
+				return nil, fmt.Errorf("invalid event (%s): %w", name, err)
+			}
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_ACTIVESPECIALV2, &eventv1.HyprActiveSpecialV2Value{Id: int32(id), Name: s[1], Monitor: s[2]})
+	case EventPin:
+		s, err := splitValue(name, value, 2)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := parseAddress(name, s[0])
+		if err != nil {
+			return nil, err
+		}
+		pinned, err := parseFlag(name, s[1])
+		if err != nil {
+			return nil, err
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_PIN, &eventv1.HyprPinValue{Address: addr, Pinned: pinned})
+	case EventConfigReloaded:
+		return &eventv1.Event{Kind: eventv1.EventKind_EVENT_KIND_HYPR_CONFIGRELOADED}, nil
+	case EventBell:
+		var addr string
+		if value != `` {
+			var err error
+			if addr, err = parseAddress(name, value); err != nil {
+				return nil, err
+			}
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_BELL, &eventv1.HyprBellValue{Address: addr})
+	case EventToggleGroup:
+		s := strings.Split(value, `,`)
+		open, err := parseFlag(name, s[0])
+		if err != nil {
+			return nil, err
+		}
+		addrs := make([]string, 0, len(s)-1)
+		for _, v := range s[1:] {
+			if v == `` {
+				continue
+			}
+			addr, err := parseAddress(name, v)
+			if err != nil {
+				return nil, err
+			}
+			addrs = append(addrs, addr)
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_TOGGLEGROUP, &eventv1.HyprToggleGroupValue{Open: open, Addresses: addrs})
+	case EventMoveIntoGroup:
+		addr, err := parseAddress(name, value)
+		if err != nil {
+			return nil, err
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_MOVEINTOGROUP, &eventv1.HyprMoveIntoGroupValue{Address: addr})
+	case EventMoveOutOfGroup:
+		addr, err := parseAddress(name, value)
+		if err != nil {
+			return nil, err
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_MOVEOUTOFGROUP, &eventv1.HyprMoveOutOfGroupValue{Address: addr})
+	case EventMonitorAddedV2:
+		s, err := splitValue(name, value, 3)
+		if err != nil {
+			return nil, err
+		}
+		id, err := strconv.Atoi(s[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid event (%s): %w", name, err)
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_MONITORADDEDV2, &eventv1.HyprMonitorAddedV2Value{Id: int32(id), Name: s[1], Description: s[2]})
+	case EventMonitorRemovedV2:
+		s, err := splitValue(name, value, 3)
+		if err != nil {
+			return nil, err
+		}
+		id, err := strconv.Atoi(s[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid event (%s): %w", name, err)
+		}
+		return newEvent(name, eventv1.EventKind_EVENT_KIND_HYPR_MONITORREMOVEDV2, &eventv1.HyprMonitorRemovedV2Value{Id: int32(id), Name: s[1], Description: s[2]})
+	case EventReconnected:
+		return &eventv1.Event{Kind: eventv1.EventKind_EVENT_KIND_HYPR_RECONNECTED}, nil
 	default:
 		return eventv1.NewString(eventv1.EventKind_EVENT_KIND_UNSPECIFIED, value)
 	}
 }
 
+// newEvent wraps msg in an event of the specified kind.
+func newEvent(name Event, kind eventv1.EventKind, msg proto.Message) (*eventv1.Event, error) {
+	data, err := anypb.New(msg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event (%s): %w", name, err)
+	}
+
+	return &eventv1.Event{
+		Kind: kind,
+		Data: data,
+	}, nil
+}
+
+// splitValue splits a comma-separated event value into exactly n fields, the
+// last field may contain commas.
+func splitValue(name Event, value string, n int) ([]string, error) {
+	s := strings.SplitN(value, `,`, n)
+	if len(s) != n {
+		return nil, fmt.Errorf("invalid event (%s): expected %d fields, got %q", name, n, value)
+	}
+
+	return s, nil
+}
+
+// parseAddress validates a hexadecimal window address, and returns it with the 0x prefix.
+func parseAddress(name Event, value string) (string, error) {
+	value = strings.TrimPrefix(value, `0x`)
+	if _, err := strconv.ParseUint(value, 16, 64); err != nil {
+		return ``, fmt.Errorf("invalid event (%s): invalid window address %q", name, value)
+	}
+
+	return `0x` + value, nil
+}
+
+// parseFlag parses a 0 or 1 event field.
+func parseFlag(name Event, value string) (bool, error) {
+	switch value {
+	case `0`:
+		return false, nil
+	case `1`:
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid event (%s): invalid flag %q", name, value)
+	}
+}
+
 func socketPath(sock string) (string, error) {
 	s := path.Join(os.Getenv(`XDG_RUNTIME_DIR`), `hypr`, os.Getenv(`HYPRLAND_INSTANCE_SIGNATURE`), sock)
 	_, err := os.Stat(s)
@@ -0,0 +1,320 @@
+package hypripc_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pdf/hyprpanel/internal/eventbus"
+	"github.com/pdf/hyprpanel/internal/hypripc"
+	"github.com/pdf/hyprpanel/internal/hypripc/hypripctest"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const eventTimeout = 2 * time.Second
+
+// newServer starts a fake Hyprland server, and points hypripc at it for the
+// duration of the test.
+func newServer(t *testing.T, model *hypripctest.Model) *hypripctest.Server {
+	t.Helper()
+	srv, err := hypripctest.NewServer(model)
+	if err != nil {
+		t.Fatalf("failed starting server: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := srv.Close(); err != nil {
+			t.Errorf("failed closing server: %v", err)
+		}
+	})
+	t.Setenv(`XDG_RUNTIME_DIR`, srv.RuntimeDir())
+	t.Setenv(`HYPRLAND_INSTANCE_SIGNATURE`, srv.Signature())
+
+	return srv
+}
+
+// newClient connects to the fake server and starts the event loop.
+func newClient(t *testing.T, srv *hypripctest.Server) *hypripc.HyprIPC {
+	t.Helper()
+	h, err := hypripc.New(hclog.NewNullLogger())
+	if err != nil {
+		t.Fatalf("failed connecting: %v", err)
+	}
+	t.Cleanup(h.Close)
+	h.StartEvents()
+	if err := srv.WaitEventClients(1, eventTimeout); err != nil {
+		t.Fatal(err)
+	}
+
+	return h
+}
+
+func receive(t *testing.T, ch <-chan *eventv1.Event) *eventv1.Event {
+	t.Helper()
+	select {
+	case evt := <-ch:
+		return evt
+	case <-time.After(eventTimeout):
+		t.Fatal(`timed out waiting for event`)
+		return nil
+	}
+}
+
+func mustAny(t *testing.T, msg proto.Message) *anypb.Any {
+	t.Helper()
+	data, err := anypb.New(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func TestHyprToEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   hypripc.Event
+		value   string
+		want    *eventv1.Event
+		wantErr bool
+	}{
+		{
+			name:  `workspace`,
+			event: hypripc.EventWorkspace,
+			value: `2`,
+			want:  &eventv1.Event{Kind: eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACE, Data: mustAny(t, wrapperspb.String(`2`))},
+		},
+		{
+			name:  `workspacev2`,
+			event: hypripc.EventWorkspaceV2,
+			value: `2,web`,
+			want: &eventv1.Event{
+				Kind: eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACEV2,
+				Data: mustAny(t, &eventv1.HyprWorkspaceV2Value{Id: 2, Name: `web`}),
+			},
+		},
+		{
+			name:    `workspacev2 invalid id`,
+			event:   hypripc.EventWorkspaceV2,
+			value:   `x,web`,
+			wantErr: true,
+		},
+		{
+			name:  `activewindowv2`,
+			event: hypripc.EventActiveWindowV2,
+			value: `55d4a8e0b3c0`,
+			want: &eventv1.Event{
+				Kind: eventv1.EventKind_EVENT_KIND_HYPR_ACTIVEWINDOWV2,
+				Data: mustAny(t, &eventv1.HyprActiveWindowV2Value{Address: `0x55d4a8e0b3c0`}),
+			},
+		},
+		{
+			name:  `activewindowv2 none`,
+			event: hypripc.EventActiveWindowV2,
+			value: `,`,
+			want: &eventv1.Event{
+				Kind: eventv1.EventKind_EVENT_KIND_HYPR_ACTIVEWINDOWV2,
+				Data: mustAny(t, &eventv1.HyprActiveWindowV2Value{}),
+			},
+		},
+		{
+			name:  `openwindow title with commas`,
+			event: hypripc.EventOpenWindow,
+			value: `abc,1,foot,a, b, c`,
+			want: &eventv1.Event{
+				Kind: eventv1.EventKind_EVENT_KIND_HYPR_OPENWINDOW,
+				Data: mustAny(t, &eventv1.HyprOpenWindowValue{Address: `abc`, WorkspaceName: `1`, Class: `foot`, Title: `a, b, c`}),
+			},
+		},
+		{
+			name:  `closewindow`,
+			event: hypripc.EventCloseWindow,
+			value: `abc`,
+			want:  &eventv1.Event{Kind: eventv1.EventKind_EVENT_KIND_HYPR_CLOSEWINDOW, Data: mustAny(t, wrapperspb.String(`0xabc`))},
+		},
+		{
+			name:  `movewindowv2`,
+			event: hypripc.EventMoveWindowV2,
+			value: `abc,2,web`,
+			want: &eventv1.Event{
+				Kind: eventv1.EventKind_EVENT_KIND_HYPR_MOVEWINDOWV2,
+				Data: mustAny(t, &eventv1.HyprMoveWindowV2Value{Address: `0xabc`, WorkspaceId: 2, WorkspaceName: `web`}),
+			},
+		},
+		{
+			name:    `movewindowv2 invalid id`,
+			event:   hypripc.EventMoveWindowV2,
+			value:   `abc,x,web`,
+			wantErr: true,
+		},
+		{
+			name:  `pin`,
+			event: hypripc.EventPin,
+			value: `abc,1`,
+			want: &eventv1.Event{
+				Kind: eventv1.EventKind_EVENT_KIND_HYPR_PIN,
+				Data: mustAny(t, &eventv1.HyprPinValue{Address: `0xabc`, Pinned: true}),
+			},
+		},
+		{
+			name:    `pin invalid flag`,
+			event:   hypripc.EventPin,
+			value:   `abc,2`,
+			wantErr: true,
+		},
+		{
+			name:  `togglegroup`,
+			event: hypripc.EventToggleGroup,
+			value: `1,abc,def`,
+			want: &eventv1.Event{
+				Kind: eventv1.EventKind_EVENT_KIND_HYPR_TOGGLEGROUP,
+				Data: mustAny(t, &eventv1.HyprToggleGroupValue{Open: true, Addresses: []string{`0xabc`, `0xdef`}}),
+			},
+		},
+		{
+			name:  `configreloaded`,
+			event: hypripc.EventConfigReloaded,
+			want:  &eventv1.Event{Kind: eventv1.EventKind_EVENT_KIND_HYPR_CONFIGRELOADED},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := hypripc.HyprToEvent(tt.event, tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueries(t *testing.T) {
+	model := &hypripctest.Model{
+		Monitors:   []hypripc.Monitor{{ID: 0, Name: `DP-1`}, {ID: 1, Name: `DP-2`, Disabled: true}},
+		Workspaces: []hypripc.Workspace{{ID: 1, Name: `1`, Monitor: `DP-1`}},
+		Clients:    []hypripc.Client{{Address: `0xabc`, Class: `foot`}},
+	}
+	srv := newServer(t, model)
+	h := newClient(t, srv)
+
+	clients, err := h.Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clients) != 1 || clients[0].Address != `0xabc` {
+		t.Errorf("unexpected clients: %v", clients)
+	}
+
+	var (
+		monitors   []hypripc.Monitor
+		workspaces []hypripc.Workspace
+	)
+	if err := h.Batch().Monitors(&monitors).Workspaces(&workspaces).Run(); err != nil {
+		t.Fatal(err)
+	}
+	if len(monitors) != 2 || len(workspaces) != 1 {
+		t.Errorf("unexpected batch results: monitors=%v workspaces=%v", monitors, workspaces)
+	}
+
+	if err := h.Dispatch(hypripc.DispatchWorkspace, `1`); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Batch().Dispatch(hypripc.DispatchWorkspace, `9`).Run(); err == nil {
+		t.Error(`expected error dispatching to unknown workspace`)
+	}
+	if got := srv.Dispatches(); len(got) != 2 || got[0] != `workspace 1` {
+		t.Errorf("unexpected dispatches: %v", got)
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	srv := newServer(t, nil)
+	h := newClient(t, srv)
+
+	all, cancelAll := h.Subscribe()
+	defer cancelAll()
+	windows, cancelWindows := h.Subscribe(eventbus.WithKinds(eventv1.EventKind_EVENT_KIND_HYPR_CLOSEWINDOW))
+	defer cancelWindows()
+
+	if err := srv.EmitLine(`not an event`); err != nil {
+		t.Fatal(err)
+	}
+	if err := srv.Emit(hypripc.EventPin, `abc,nope`); err != nil {
+		t.Fatal(err)
+	}
+	if err := srv.Emit(hypripc.EventWorkspace, `2`); err != nil {
+		t.Fatal(err)
+	}
+	if err := srv.Emit(hypripc.EventCloseWindow, `abc`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Malformed lines and values are skipped, valid events are delivered in
+	// order.
+	if evt := receive(t, all); evt.Kind != eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACE {
+		t.Errorf("got %v, want workspace event", evt.Kind)
+	}
+	if evt := receive(t, all); evt.Kind != eventv1.EventKind_EVENT_KIND_HYPR_CLOSEWINDOW {
+		t.Errorf("got %v, want closewindow event", evt.Kind)
+	}
+	// Filtered subscribers only receive matching kinds.
+	if evt := receive(t, windows); evt.Kind != eventv1.EventKind_EVENT_KIND_HYPR_CLOSEWINDOW {
+		t.Errorf("got %v, want closewindow event", evt.Kind)
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	srv := newServer(t, nil)
+	h := newClient(t, srv)
+
+	ch, cancel := h.Subscribe()
+	defer cancel()
+
+	srv.DisconnectEvents()
+	if evt := receive(t, ch); evt.Kind != eventv1.EventKind_EVENT_KIND_HYPR_RECONNECTED {
+		t.Fatalf("got %v, want reconnected event", evt.Kind)
+	}
+	if err := srv.WaitEventClients(1, eventTimeout); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := srv.Emit(hypripc.EventWorkspace, `3`); err != nil {
+		t.Fatal(err)
+	}
+	if evt := receive(t, ch); evt.Kind != eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACE {
+		t.Errorf("got %v, want workspace event after reconnect", evt.Kind)
+	}
+}
+
+func TestReconnectInstanceChanged(t *testing.T) {
+	srv := newServer(t, nil)
+	h := newClient(t, srv)
+
+	ch, cancel := h.Subscribe()
+	defer cancel()
+
+	// Simulate a restart, the previous instance no longer exists.
+	t.Setenv(`HYPRLAND_INSTANCE_SIGNATURE`, `stale_1_1`)
+	srv.DisconnectEvents()
+	if evt := receive(t, ch); evt.Kind != eventv1.EventKind_EVENT_KIND_HYPR_RECONNECTED {
+		t.Fatalf("got %v, want reconnected event", evt.Kind)
+	}
+	if got := os.Getenv(`HYPRLAND_INSTANCE_SIGNATURE`); got != srv.Signature() {
+		t.Errorf("got instance %q, want %q", got, srv.Signature())
+	}
+	if _, err := h.Clients(); err != nil {
+		t.Errorf("request failed after instance change: %v", err)
+	}
+}
@@ -0,0 +1,454 @@
+// Package hypripctest provides a fake Hyprland IPC server, for exercising
+// hypripc without a running compositor.
+package hypripctest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pdf/hyprpanel/internal/hypripc"
+)
+
+const (
+	batchPrefix    = `[[BATCH]]`
+	batchDelimiter = "\n\n\n"
+	maxRequestSize = 64 * 1024
+)
+
+// Model is the compositor state served by Server.
+type Model struct {
+	Monitors        []hypripc.Monitor
+	Workspaces      []hypripc.Workspace
+	Clients         []hypripc.Client
+	ActiveWindow    hypripc.Client
+	ActiveWorkspace hypripc.Workspace
+	Version         hypripc.Version
+}
+
+// HandlerFunc answers a request, args holds everything after the command.
+type HandlerFunc func(args string) []byte
+
+// DispatchFunc handles a dispatcher, args holds the dispatcher arguments. A
+// non-nil error is returned to the client in place of `ok`.
+type DispatchFunc func(args string) error
+
+// Server serves the Hyprland request and event sockets on temporary Unix
+// sockets. Handlers answer `activewindow`, `activeworkspace`, `clients`,
+// `workspaces`, `monitors`, `version`, `keyword` and `dispatch` from the
+// Model, and may be replaced or extended with Handle and HandleDispatch.
+type Server struct {
+	dir        string
+	sig        string
+	ctrl       net.Listener
+	evt        net.Listener
+	model      Model
+	handlers   map[string]HandlerFunc
+	dispatch   map[string]DispatchFunc
+	evtConns   map[net.Conn]struct{}
+	requests   []string
+	dispatches []string
+	wg         sync.WaitGroup
+	mu         sync.Mutex
+}
+
+// RuntimeDir returns the directory to use as XDG_RUNTIME_DIR.
+func (s *Server) RuntimeDir() string {
+	return s.dir
+}
+
+// Signature returns the instance signature to use as HYPRLAND_INSTANCE_SIGNATURE.
+func (s *Server) Signature() string {
+	return s.sig
+}
+
+// Env returns the environment variables that direct hypripc to the server, in
+// `KEY=value` form.
+func (s *Server) Env() []string {
+	return []string{
+		`XDG_RUNTIME_DIR=` + s.dir,
+		`HYPRLAND_INSTANCE_SIGNATURE=` + s.sig,
+	}
+}
+
+// Setenv points hypripc at the server for the current process.
+func (s *Server) Setenv() error {
+	if err := os.Setenv(`XDG_RUNTIME_DIR`, s.dir); err != nil {
+		return err
+	}
+
+	return os.Setenv(`HYPRLAND_INSTANCE_SIGNATURE`, s.sig)
+}
+
+// Model returns a copy of the current model.
+func (s *Server) Model() Model {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return cloneModel(s.model)
+}
+
+// Update modifies the model, fn must not call other Server methods.
+func (s *Server) Update(fn func(m *Model)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.model)
+}
+
+// Handle registers a handler for command, replacing any existing handler.
+func (s *Server) Handle(command string, fn HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[command] = fn
+}
+
+// HandleDispatch registers a handler for the dispatcher name, replacing any
+// existing handler.
+func (s *Server) HandleDispatch(name string, fn DispatchFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dispatch[name] = fn
+}
+
+// Requests returns the raw requests received, in order, batches are recorded
+// as a single request.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.requests)
+}
+
+// Dispatches returns the dispatcher calls received, in order, eg
+// `workspace 2`.
+func (s *Server) Dispatches() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.dispatches)
+}
+
+// Emit sends the event `name>>value` to all connected event clients.
+func (s *Server) Emit(name hypripc.Event, value string) error {
+	return s.EmitLine(string(name) + `>>` + value)
+}
+
+// EmitLine sends a raw line to all connected event clients, for testing
+// malformed events.
+func (s *Server) EmitLine(line string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var errs []error
+	for conn := range s.evtConns {
+		if _, err := io.WriteString(conn, line+"\n"); err != nil {
+			errs = append(errs, err)
+			_ = conn.Close()
+			delete(s.evtConns, conn)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// EventClients returns the number of connected event clients.
+func (s *Server) EventClients() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.evtConns)
+}
+
+// WaitEventClients waits until at least n event clients are connected, as
+// connections are accepted asynchronously, events emitted before then may
+// not be delivered.
+func (s *Server) WaitEventClients(n int, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for s.EventClients() < n {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for %d event clients, have %d", n, s.EventClients())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	return nil
+}
+
+// DisconnectEvents closes all event client connections, leaving the event
+// socket open so that clients may reconnect.
+func (s *Server) DisconnectEvents() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.evtConns {
+		_ = conn.Close()
+		delete(s.evtConns, conn)
+	}
+}
+
+// Close stops the server, and removes the runtime directory.
+func (s *Server) Close() error {
+	errs := []error{s.ctrl.Close(), s.evt.Close()}
+	s.DisconnectEvents()
+	s.wg.Wait()
+	errs = append(errs, os.RemoveAll(s.dir))
+
+	return errors.Join(errs...)
+}
+
+func (s *Server) serveRequests() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ctrl.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			buf := make([]byte, maxRequestSize)
+			n, err := conn.Read(buf)
+			if err != nil {
+				return
+			}
+			_, _ = conn.Write(s.handle(string(buf[:n])))
+		}()
+	}
+}
+
+func (s *Server) serveEvents() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.evt.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.evtConns[conn] = struct{}{}
+		s.mu.Unlock()
+	}
+}
+
+func (s *Server) handle(req string) []byte {
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.mu.Unlock()
+
+	if batch, ok := strings.CutPrefix(req, batchPrefix); ok {
+		cmds := strings.Split(batch, `;`)
+		replies := make([][]byte, len(cmds))
+		for i, cmd := range cmds {
+			replies[i] = s.handleCommand(cmd)
+		}
+		return []byte(strings.Join(toStrings(replies), batchDelimiter))
+	}
+
+	return s.handleCommand(req)
+}
+
+func (s *Server) handleCommand(cmd string) []byte {
+	cmd = strings.TrimSpace(cmd)
+	// Flags precede the command, separated by a slash, eg `j/clients`.
+	if flags, rest, ok := strings.Cut(cmd, `/`); ok && !strings.Contains(flags, ` `) {
+		cmd = rest
+	}
+	name, args, _ := strings.Cut(cmd, ` `)
+
+	s.mu.Lock()
+	fn, ok := s.handlers[name]
+	s.mu.Unlock()
+	if !ok {
+		return []byte(`unknown request`)
+	}
+
+	return fn(args)
+}
+
+func (s *Server) handleDispatch(args string) []byte {
+	name, dispatchArgs, _ := strings.Cut(args, ` `)
+
+	s.mu.Lock()
+	s.dispatches = append(s.dispatches, args)
+	fn, ok := s.dispatch[name]
+	s.mu.Unlock()
+	if !ok {
+		return []byte(`Invalid dispatcher`)
+	}
+	if err := fn(dispatchArgs); err != nil {
+		return []byte(err.Error())
+	}
+
+	return []byte(`ok`)
+}
+
+// query returns a handler that encodes the result of fn, evaluated against
+// the model.
+func (s *Server) query(fn func(m *Model) any) HandlerFunc {
+	return func(string) []byte {
+		s.mu.Lock()
+		v := fn(&s.model)
+		b, err := json.Marshal(v)
+		s.mu.Unlock()
+		if err != nil {
+			return []byte(err.Error())
+		}
+
+		return b
+	}
+}
+
+// dispatchWorkspace switches to the workspace identified by ID or name.
+func (s *Server) dispatchWorkspace(args string) error {
+	s.mu.Lock()
+	idx := slices.IndexFunc(s.model.Workspaces, func(ws hypripc.Workspace) bool {
+		return ws.Name == args || strconv.Itoa(ws.ID) == args
+	})
+	if idx < 0 {
+		s.mu.Unlock()
+		return fmt.Errorf("workspace not found: %s", args)
+	}
+	ws := s.model.Workspaces[idx]
+	s.model.ActiveWorkspace = ws
+	for i := range s.model.Monitors {
+		if s.model.Monitors[i].Name == ws.Monitor {
+			s.model.Monitors[i].ActiveWorkspace.ID = ws.ID
+			s.model.Monitors[i].ActiveWorkspace.Name = ws.Name
+		}
+	}
+	s.mu.Unlock()
+
+	return errors.Join(
+		s.Emit(hypripc.EventWorkspace, ws.Name),
+		s.Emit(hypripc.EventWorkspaceV2, fmt.Sprintf("%d,%s", ws.ID, ws.Name)),
+	)
+}
+
+// dispatchFocusWindow focuses the client identified by `address:<address>`.
+func (s *Server) dispatchFocusWindow(args string) error {
+	addr, ok := strings.CutPrefix(args, `address:`)
+	if !ok {
+		return fmt.Errorf("unsupported window selector: %s", args)
+	}
+
+	s.mu.Lock()
+	idx := slices.IndexFunc(s.model.Clients, func(c hypripc.Client) bool {
+		return c.Address == addr
+	})
+	if idx < 0 {
+		s.mu.Unlock()
+		return fmt.Errorf("window not found: %s", args)
+	}
+	client := s.model.Clients[idx]
+	s.model.ActiveWindow = client
+	s.mu.Unlock()
+
+	return errors.Join(
+		s.Emit(hypripc.EventActiveWindow, client.Class+`,`+client.Title),
+		s.Emit(hypripc.EventActiveWindowV2, strings.TrimPrefix(client.Address, `0x`)),
+	)
+}
+
+func cloneModel(m Model) Model {
+	m.Monitors = slices.Clone(m.Monitors)
+	m.Workspaces = slices.Clone(m.Workspaces)
+	m.Clients = slices.Clone(m.Clients)
+
+	return m
+}
+
+func toStrings(b [][]byte) []string {
+	s := make([]string, len(b))
+	for i := range b {
+		s[i] = string(b[i])
+	}
+
+	return s
+}
+
+// NewServer starts a server in a new temporary runtime directory, serving
+// model, which may be nil. The server must be closed to remove the directory.
+func NewServer(model *Model) (*Server, error) {
+	dir, err := os.MkdirTemp(``, `hypripctest`)
+	if err != nil {
+		return nil, err
+	}
+	sig := fmt.Sprintf("hypripctest_%d_%d", time.Now().Unix(), os.Getpid())
+	instDir := path.Join(dir, `hypr`, sig)
+	if err := os.MkdirAll(instDir, 0o700); err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+	if err := os.WriteFile(path.Join(instDir, `hyprland.lock`), []byte(strconv.Itoa(os.Getpid())+"\n"), 0o600); err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	ctrl, err := net.Listen(`unix`, path.Join(instDir, `.socket.sock`))
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+	evt, err := net.Listen(`unix`, path.Join(instDir, `.socket2.sock`))
+	if err != nil {
+		_ = ctrl.Close()
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	s := &Server{
+		dir:      dir,
+		sig:      sig,
+		ctrl:     ctrl,
+		evt:      evt,
+		handlers: make(map[string]HandlerFunc),
+		dispatch: make(map[string]DispatchFunc),
+		evtConns: make(map[net.Conn]struct{}),
+	}
+	if model != nil {
+		s.model = cloneModel(*model)
+	}
+
+	s.handlers[`activewindow`] = s.query(func(m *Model) any { return m.ActiveWindow })
+	s.handlers[`activeworkspace`] = s.query(func(m *Model) any { return m.ActiveWorkspace })
+	s.handlers[`clients`] = s.query(func(m *Model) any { return nonNil(m.Clients) })
+	s.handlers[`workspaces`] = s.query(func(m *Model) any { return nonNil(m.Workspaces) })
+	s.handlers[`version`] = s.query(func(m *Model) any { return m.Version })
+	s.handlers[`monitors`] = func(args string) []byte {
+		return s.query(func(m *Model) any {
+			if args == `all` {
+				return nonNil(m.Monitors)
+			}
+			return nonNil(slices.DeleteFunc(slices.Clone(m.Monitors), func(mon hypripc.Monitor) bool {
+				return mon.Disabled
+			}))
+		})(args)
+	}
+	s.handlers[`keyword`] = func(string) []byte { return []byte(`ok`) }
+	s.handlers[`dispatch`] = s.handleDispatch
+	s.dispatch[`workspace`] = s.dispatchWorkspace
+	s.dispatch[`focuswindow`] = s.dispatchFocusWindow
+
+	s.wg.Add(2)
+	go s.serveRequests()
+	go s.serveEvents()
+
+	return s, nil
+}
+
+// nonNil returns an empty slice in place of nil, so that lists encode as `[]`.
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return make([]T, 0)
+	}
+
+	return s
+}
@@ -0,0 +1,141 @@
+package hypripc
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+const instanceLockFile = `hyprland.lock`
+
+// Instance of Hyprland running for the current user.
+type Instance struct {
+	Instance string `json:"instance"`
+	Time     uint64 `json:"time"`
+	Pid      int    `json:"pid"`
+	WlSocket string `json:"wl_socket"`
+}
+
+// Instances returns the live Hyprland instances for the current user. Like
+// `hyprctl instances`, this inspects the runtime directory rather than
+// querying an instance.
+func Instances() ([]Instance, error) {
+	dir := path.Join(os.Getenv(`XDG_RUNTIME_DIR`), `hypr`)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return make([]Instance, 0), nil
+		}
+		return nil, err
+	}
+
+	instances := make([]Instance, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		inst, err := readInstance(dir, entry.Name())
+		if err != nil || !processLive(inst.Pid) {
+			continue
+		}
+		instances = append(instances, inst)
+	}
+
+	return instances, nil
+}
+
+// SelectInstance returns the Hyprland instance to connect to. The instance
+// with signature sig takes precedence, followed by the instance identified by
+// HYPRLAND_INSTANCE_SIGNATURE. Otherwise, live instances are discovered,
+// preferring the instance serving WAYLAND_DISPLAY, then the most recently
+// started instance.
+func SelectInstance(sig string) (Instance, error) {
+	if sig == `` {
+		sig = os.Getenv(`HYPRLAND_INSTANCE_SIGNATURE`)
+	}
+	if sig != `` {
+		inst, err := readInstance(path.Join(os.Getenv(`XDG_RUNTIME_DIR`), `hypr`), sig)
+		if err != nil {
+			// The lock file is informational, the socket may still be found.
+			return Instance{Instance: sig}, nil
+		}
+		return inst, nil
+	}
+
+	return discoverInstance()
+}
+
+// discoverInstance returns the live instance serving WAYLAND_DISPLAY, or else
+// the most recently started live instance.
+func discoverInstance() (Instance, error) {
+	instances, err := Instances()
+	if err != nil {
+		return Instance{}, err
+	}
+	if len(instances) == 0 {
+		return Instance{}, errors.New(`no running hyprland instances found`)
+	}
+	if display := os.Getenv(`WAYLAND_DISPLAY`); display != `` {
+		for _, inst := range instances {
+			if inst.WlSocket == display {
+				return inst, nil
+			}
+		}
+	}
+	latest := instances[0]
+	for _, inst := range instances[1:] {
+		if inst.Time > latest.Time {
+			latest = inst
+		}
+	}
+
+	return latest, nil
+}
+
+// instanceLive reports whether the instance with signature sig is running.
+func instanceLive(sig string) bool {
+	inst, err := readInstance(path.Join(os.Getenv(`XDG_RUNTIME_DIR`), `hypr`), sig)
+	if err != nil {
+		return false
+	}
+
+	return processLive(inst.Pid)
+}
+
+func processLive(pid int) bool {
+	err := syscall.Kill(pid, 0)
+
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
+
+// readInstance parses the lock file for the instance with signature sig. The
+// lock file contains the PID and Wayland socket name on separate lines, and
+// the signature is of the form "<commit>_<time>_<random>".
+func readInstance(dir, sig string) (Instance, error) {
+	inst := Instance{Instance: sig}
+	f, err := os.Open(path.Join(dir, sig, instanceLockFile))
+	if err != nil {
+		return inst, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() {
+		return inst, errors.New(`empty lock file`)
+	}
+	if inst.Pid, err = strconv.Atoi(strings.TrimSpace(scanner.Text())); err != nil {
+		return inst, err
+	}
+	if scanner.Scan() {
+		inst.WlSocket = strings.TrimSpace(scanner.Text())
+	}
+	if parts := strings.Split(sig, `_`); len(parts) >= 2 {
+		inst.Time, _ = strconv.ParseUint(parts[1], 10, 64)
+	}
+
+	return inst, scanner.Err()
+}
@@ -0,0 +1,18 @@
+package hypripc
+
+// Layer surface container.
+type Layer struct {
+	Address   string `json:"address"`
+	X         int    `json:"x"`
+	Y         int    `json:"y"`
+	W         int    `json:"w"`
+	H         int    `json:"h"`
+	Namespace string `json:"namespace"`
+	Pid       int64  `json:"pid"`
+}
+
+// MonitorLayers contains the layer surfaces on a monitor, keyed by layer
+// level, from background ("0") to overlay ("3").
+type MonitorLayers struct {
+	Levels map[string][]Layer `json:"levels"`
+}
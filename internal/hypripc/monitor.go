@@ -26,6 +26,7 @@ type Monitor struct {
 	Transform       int     `json:"transform"`
 	Focused         bool    `json:"focused"`
 	DpmsStatus      bool    `json:"dpmsStatus"`
+	Disabled        bool    `json:"disabled"`
 	Vrr             bool    `json:"vrr"`
 	ActivelyTearing bool    `json:"activelyTearing"`
 }
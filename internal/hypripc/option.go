@@ -0,0 +1,17 @@
+package hypripc
+
+// Option value. Only the field matching the type of the option is populated.
+type Option struct {
+	Option string  `json:"option"`
+	Int    int64   `json:"int"`
+	Float  float64 `json:"float"`
+	Str    string  `json:"str"`
+	Custom string  `json:"custom"`
+	Set    bool    `json:"set"`
+}
+
+// CursorPos cursor position in the global layout.
+type CursorPos struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
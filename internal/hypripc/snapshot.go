@@ -0,0 +1,121 @@
+package hypripc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pdf/hyprpanel/internal/eventbus"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+)
+
+// ErrOffline is returned for requests that can not be answered by a client
+// created with NewOffline.
+var ErrOffline = errors.New(`hyprland IPC offline`)
+
+// Snapshot of the Hyprland state, as returned by the IPC queries.
+type Snapshot struct {
+	Version         Version     `json:"version"`
+	Monitors        []Monitor   `json:"monitors"`
+	Workspaces      []Workspace `json:"workspaces"`
+	Clients         []Client    `json:"clients"`
+	ActiveWindow    Client      `json:"activewindow"`
+	ActiveWorkspace Workspace   `json:"activeworkspace"`
+}
+
+// Snapshot queries the current Hyprland state in a single request.
+func (h *HyprIPC) Snapshot() (*Snapshot, error) {
+	s := &Snapshot{}
+	if err := h.Batch().
+		Query(&s.Version, `version`).
+		Monitors(&s.Monitors).
+		Workspaces(&s.Workspaces).
+		Clients(&s.Clients).
+		ActiveWindow(&s.ActiveWindow).
+		ActiveWorkspace(&s.ActiveWorkspace).
+		Run(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Value encodes the snapshot for transport.
+func (s *Snapshot) Value() (*eventv1.HyprSnapshotValue, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventv1.HyprSnapshotValue{Data: string(b)}, nil
+}
+
+// SnapshotFromValue decodes a snapshot encoded by Snapshot.Value.
+func SnapshotFromValue(v *eventv1.HyprSnapshotValue) (*Snapshot, error) {
+	s := &Snapshot{}
+	if err := json.Unmarshal([]byte(v.Data), s); err != nil {
+		return nil, fmt.Errorf("invalid hyprland snapshot: %w", err)
+	}
+
+	return s, nil
+}
+
+// Offline reports whether the client was created with NewOffline.
+func (h *HyprIPC) Offline() bool {
+	return h.offline != nil
+}
+
+// offlineReply answers req from the snapshot of an offline client. Queries
+// not covered by the snapshot, and all commands, fail with ErrOffline.
+func (h *HyprIPC) offlineReply(req string) ([]byte, error) {
+	if batch, ok := strings.CutPrefix(req, batchPrefix); ok {
+		cmds := strings.Split(batch, `;`)
+		replies := make([]string, len(cmds))
+		for i, cmd := range cmds {
+			res, err := h.offlineReply(cmd)
+			if err != nil {
+				res = []byte(err.Error())
+			}
+			replies[i] = string(res)
+		}
+		return []byte(strings.Join(replies, batchDelimiter)), nil
+	}
+
+	var v any
+	switch strings.TrimPrefix(req, `j/`) {
+	case `version`:
+		v = h.offline.Version
+	case `monitors`, `monitors all`:
+		v = h.offline.Monitors
+	case `workspaces`:
+		v = h.offline.Workspaces
+	case `clients`:
+		v = h.offline.Clients
+	case `activewindow`:
+		v = h.offline.ActiveWindow
+	case `activeworkspace`:
+		v = h.offline.ActiveWorkspace
+	default:
+		return nil, ErrOffline
+	}
+
+	return json.Marshal(v)
+}
+
+// NewOffline instantiates a HyprIPC client that does not connect to Hyprland.
+// Queries are answered from snap, which never changes, commands fail with
+// ErrOffline, and no events are emitted.
+func NewOffline(log hclog.Logger, snap *Snapshot) *HyprIPC {
+	if snap == nil {
+		snap = &Snapshot{}
+	}
+
+	return &HyprIPC{
+		log:     log,
+		bus:     eventbus.New(),
+		offline: snap,
+		quitCh:  make(chan struct{}),
+	}
+}
@@ -0,0 +1,596 @@
+package hypripc
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultResyncInterval is the interval between full resyncs of State, to
+	// correct drift in properties that are not reported by events.
+	DefaultResyncInterval = 5 * time.Second
+	// refreshDelay coalesces resyncs triggered by events that do not carry
+	// enough information to update the state directly.
+	refreshDelay = 50 * time.Millisecond
+)
+
+// StateEventKinds are the event kinds that State consumes.
+var StateEventKinds = []eventv1.EventKind{
+	eventv1.EventKind_EVENT_KIND_HYPR_ACTIVEWINDOWV2,
+	eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACEV2,
+	eventv1.EventKind_EVENT_KIND_HYPR_FOCUSEDMON,
+	eventv1.EventKind_EVENT_KIND_HYPR_ACTIVESPECIAL,
+	eventv1.EventKind_EVENT_KIND_HYPR_ACTIVESPECIALV2,
+	eventv1.EventKind_EVENT_KIND_HYPR_CREATEWORKSPACEV2,
+	eventv1.EventKind_EVENT_KIND_HYPR_DESTROYWORKSPACEV2,
+	eventv1.EventKind_EVENT_KIND_HYPR_MOVEWORKSPACEV2,
+	eventv1.EventKind_EVENT_KIND_HYPR_RENAMEWORKSPACE,
+	eventv1.EventKind_EVENT_KIND_HYPR_OPENWINDOW,
+	eventv1.EventKind_EVENT_KIND_HYPR_CLOSEWINDOW,
+	eventv1.EventKind_EVENT_KIND_HYPR_MOVEWINDOWV2,
+	eventv1.EventKind_EVENT_KIND_HYPR_WINDOWTITLE,
+	eventv1.EventKind_EVENT_KIND_HYPR_WINDOWTITLEV2,
+	eventv1.EventKind_EVENT_KIND_HYPR_CHANGEFLOATINGMODE,
+	eventv1.EventKind_EVENT_KIND_HYPR_PIN,
+	eventv1.EventKind_EVENT_KIND_HYPR_FULLSCREEN,
+	eventv1.EventKind_EVENT_KIND_HYPR_TOGGLEGROUP,
+	eventv1.EventKind_EVENT_KIND_HYPR_MOVEINTOGROUP,
+	eventv1.EventKind_EVENT_KIND_HYPR_MOVEOUTOFGROUP,
+	eventv1.EventKind_EVENT_KIND_HYPR_MONITORADDED,
+	eventv1.EventKind_EVENT_KIND_HYPR_MONITORADDEDV2,
+	eventv1.EventKind_EVENT_KIND_HYPR_MONITORREMOVED,
+	eventv1.EventKind_EVENT_KIND_HYPR_MONITORREMOVEDV2,
+	eventv1.EventKind_EVENT_KIND_HYPR_CONFIGRELOADED,
+	eventv1.EventKind_EVENT_KIND_HYPR_RECONNECTED,
+}
+
+// Change identifies the parts of State that changed.
+type Change uint
+
+const (
+	// ChangeMonitors is set when monitors are added, removed or modified.
+	ChangeMonitors Change = 1 << iota
+	// ChangeWorkspaces is set when workspaces are added, removed or modified.
+	ChangeWorkspaces
+	// ChangeClients is set when clients are added, removed or modified.
+	ChangeClients
+	// ChangeActive is set when the active window or workspace changes.
+	ChangeActive
+)
+
+// Has reports whether c includes any of the changes in o.
+func (c Change) Has(o Change) bool {
+	return c&o != 0
+}
+
+// State is an in-memory model of Hyprland monitors, workspaces and clients. It
+// is built once, updated from events where they carry enough information, and
+// resynced periodically and whenever they do not.
+type State struct {
+	h               *HyprIPC
+	log             hclog.Logger
+	caps            *Capabilities
+	interval        time.Duration
+	monitors        []Monitor
+	workspaces      map[int]Workspace
+	clients         map[string]Client
+	order           []string
+	activeWindow    string
+	activeWorkspace int
+	watchers        []chan Change
+	quitCh          chan struct{}
+	closeOnce       sync.Once
+	notifyMu        sync.Mutex
+	mu              sync.RWMutex
+}
+
+// NewState builds the initial state, and applies Hyprland events received on
+// events until the channel is closed, or the State is closed. The state is
+// resynced every interval, unless h is offline, in which case it is only
+// updated from events.
+func NewState(h *HyprIPC, log hclog.Logger, events <-chan *eventv1.Event, interval time.Duration) (*State, error) {
+	if interval <= 0 {
+		interval = DefaultResyncInterval
+	}
+	caps, err := h.Capabilities()
+	if err != nil {
+		log.Warn(`Failed detecting hyprland capabilities`, `err`, err)
+		caps = NewCapabilities(nil)
+	}
+	s := &State{
+		h:          h,
+		log:        log,
+		caps:       caps,
+		interval:   interval,
+		workspaces: make(map[int]Workspace),
+		clients:    make(map[string]Client),
+		quitCh:     make(chan struct{}),
+	}
+	if err := s.resync(); err != nil {
+		return nil, err
+	}
+	go s.run(events)
+
+	return s, nil
+}
+
+// Monitors returns all monitors, ordered by ID.
+func (s *State) Monitors() []Monitor {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return slices.Clone(s.monitors)
+}
+
+// Workspaces returns all workspaces, ordered by ID.
+func (s *State) Workspaces() []Workspace {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	workspaces := make([]Workspace, 0, len(s.workspaces))
+	for _, ws := range s.workspaces {
+		workspaces = append(workspaces, ws)
+	}
+	slices.SortFunc(workspaces, func(a, b Workspace) int {
+		return a.ID - b.ID
+	})
+
+	return workspaces
+}
+
+// Clients returns all clients, in the order reported by Hyprland, followed by
+// clients opened since the last resync.
+func (s *State) Clients() []Client {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	clients := make([]Client, 0, len(s.order))
+	for _, addr := range s.order {
+		clients = append(clients, s.clients[addr])
+	}
+
+	return clients
+}
+
+// Client returns the client with the specified address.
+func (s *State) Client(addr string) (Client, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	client, ok := s.clients[addr]
+
+	return client, ok
+}
+
+// ActiveWindow returns the active window client, the address is empty if no
+// window is active.
+func (s *State) ActiveWindow() Client {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if client, ok := s.clients[s.activeWindow]; ok {
+		return client
+	}
+
+	return Client{Address: s.activeWindow}
+}
+
+// ActiveWorkspace returns the active workspace.
+func (s *State) ActiveWorkspace() Workspace {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if ws, ok := s.workspaces[s.activeWorkspace]; ok {
+		return ws
+	}
+
+	return Workspace{ID: s.activeWorkspace}
+}
+
+// Watch returns a channel that receives the accumulated changes to the state
+// since the last receive. The channel is not closed when cancelled.
+func (s *State) Watch() (<-chan Change, CancelFunc) {
+	ch := make(chan Change, 1)
+	s.mu.Lock()
+	s.watchers = append(s.watchers, ch)
+	s.mu.Unlock()
+
+	return ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.watchers = slices.DeleteFunc(s.watchers, func(w chan Change) bool {
+			return w == ch
+		})
+	}
+}
+
+// Close stops applying events and resyncing.
+func (s *State) Close() {
+	s.closeOnce.Do(func() {
+		close(s.quitCh)
+	})
+}
+
+func (s *State) run(events <-chan *eventv1.Event) {
+	// Offline clients always return the same snapshot, resyncing would
+	// discard the changes applied from events.
+	var tickCh, refreshCh <-chan time.Time
+	if !s.h.Offline() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		tickCh = ticker.C
+	}
+
+	for {
+		select {
+		case <-s.quitCh:
+			return
+		case <-tickCh:
+		case <-refreshCh:
+			refreshCh = nil
+		case evt, ok := <-events:
+			if !ok {
+				return
+			}
+			if s.apply(evt) && refreshCh == nil && !s.h.Offline() {
+				refreshCh = time.After(refreshDelay)
+			}
+			continue
+		}
+
+		if err := s.resync(); err != nil {
+			s.log.Warn(`Failed resyncing hyprland state`, `err`, err)
+		}
+	}
+}
+
+// resync replaces the state with a fresh copy from Hyprland, and notifies
+// watchers of any differences.
+func (s *State) resync() error {
+	var (
+		monitors        []Monitor
+		workspaces      []Workspace
+		clients         []Client
+		activeWindow    Client
+		activeWorkspace Workspace
+	)
+	if err := s.h.Batch().
+		Monitors(&monitors).
+		Workspaces(&workspaces).
+		Clients(&clients).
+		ActiveWindow(&activeWindow).
+		ActiveWorkspace(&activeWorkspace).
+		Run(); err != nil {
+		return err
+	}
+	slices.SortFunc(monitors, func(a, b Monitor) int {
+		return a.ID - b.ID
+	})
+	wsMap := make(map[int]Workspace, len(workspaces))
+	for _, ws := range workspaces {
+		wsMap[ws.ID] = ws
+	}
+	clientMap := make(map[string]Client, len(clients))
+	order := make([]string, 0, len(clients))
+	for _, client := range clients {
+		clientMap[client.Address] = client
+		order = append(order, client.Address)
+	}
+
+	var changed Change
+	s.mu.Lock()
+	if !reflect.DeepEqual(s.monitors, monitors) {
+		changed |= ChangeMonitors
+	}
+	if !reflect.DeepEqual(s.workspaces, wsMap) {
+		changed |= ChangeWorkspaces
+	}
+	if !reflect.DeepEqual(s.clients, clientMap) || !slices.Equal(s.order, order) {
+		changed |= ChangeClients
+	}
+	if s.activeWindow != activeWindow.Address || s.activeWorkspace != activeWorkspace.ID {
+		changed |= ChangeActive
+	}
+	s.monitors = monitors
+	s.workspaces = wsMap
+	s.clients = clientMap
+	s.order = order
+	s.activeWindow = activeWindow.Address
+	s.activeWorkspace = activeWorkspace.ID
+	s.mu.Unlock()
+
+	s.notify(changed)
+
+	return nil
+}
+
+// apply updates the state from evt, and reports whether a resync is required.
+func (s *State) apply(evt *eventv1.Event) bool {
+	s.mu.Lock()
+	changed, refresh := s.applyLocked(evt)
+	s.mu.Unlock()
+	s.notify(changed)
+
+	return refresh
+}
+
+func (s *State) applyLocked(evt *eventv1.Event) (Change, bool) {
+	switch evt.Kind {
+	case eventv1.EventKind_EVENT_KIND_HYPR_ACTIVEWINDOWV2:
+		data := &eventv1.HyprActiveWindowV2Value{}
+		if !s.unmarshal(evt, data) {
+			return 0, true
+		}
+		s.activeWindow = data.Address
+		return ChangeActive, false
+	case eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACEV2:
+		data := &eventv1.HyprWorkspaceV2Value{}
+		if !s.unmarshal(evt, data) {
+			return 0, true
+		}
+		s.activeWorkspace = int(data.Id)
+		for i := range s.monitors {
+			if s.monitors[i].Focused {
+				s.monitors[i].ActiveWorkspace.ID = int(data.Id)
+				s.monitors[i].ActiveWorkspace.Name = data.Name
+			}
+		}
+		_, known := s.workspaces[int(data.Id)]
+		return ChangeActive | ChangeMonitors, !known
+	case eventv1.EventKind_EVENT_KIND_HYPR_FOCUSEDMON:
+		value, err := eventv1.DataString(evt.Data)
+		if err != nil {
+			return 0, true
+		}
+		name, wsName, _ := strings.Cut(value, `,`)
+		for i := range s.monitors {
+			s.monitors[i].Focused = s.monitors[i].Name == name
+		}
+		for _, ws := range s.workspaces {
+			if ws.Name == wsName {
+				s.activeWorkspace = ws.ID
+			}
+		}
+		return ChangeActive | ChangeMonitors, false
+	case eventv1.EventKind_EVENT_KIND_HYPR_ACTIVESPECIALV2:
+		data := &eventv1.HyprActiveSpecialV2Value{}
+		if !s.unmarshal(evt, data) {
+			return 0, true
+		}
+		for i := range s.monitors {
+			if s.monitors[i].Name == data.Monitor {
+				s.monitors[i].SpecialWorkspace.ID = int(data.Id)
+				s.monitors[i].SpecialWorkspace.Name = data.Name
+			}
+		}
+		return ChangeMonitors, false
+	case eventv1.EventKind_EVENT_KIND_HYPR_CREATEWORKSPACEV2:
+		data := &eventv1.HyprCreateWorkspaceV2Value{}
+		if !s.unmarshal(evt, data) {
+			return 0, true
+		}
+		// The monitor is not reported, so the resync completes the workspace.
+		s.workspaces[int(data.Id)] = Workspace{ID: int(data.Id), Name: data.Name}
+		return ChangeWorkspaces, true
+	case eventv1.EventKind_EVENT_KIND_HYPR_DESTROYWORKSPACEV2:
+		data := &eventv1.HyprDestroyWorkspaceV2Value{}
+		if !s.unmarshal(evt, data) {
+			return 0, true
+		}
+		delete(s.workspaces, int(data.Id))
+		return ChangeWorkspaces, false
+	case eventv1.EventKind_EVENT_KIND_HYPR_MOVEWORKSPACEV2:
+		data := &eventv1.HyprMoveWorkspaceV2Value{}
+		if !s.unmarshal(evt, data) {
+			return 0, true
+		}
+		ws, ok := s.workspaces[int(data.Id)]
+		if !ok {
+			return 0, true
+		}
+		ws.Monitor = data.Monitor
+		for _, mon := range s.monitors {
+			if mon.Name == data.Monitor {
+				ws.MonitorID = mon.ID
+			}
+		}
+		s.workspaces[ws.ID] = ws
+		for addr, client := range s.clients {
+			if client.Workspace.ID == ws.ID {
+				client.Monitor = ws.MonitorID
+				s.clients[addr] = client
+			}
+		}
+		return ChangeWorkspaces | ChangeClients, false
+	case eventv1.EventKind_EVENT_KIND_HYPR_RENAMEWORKSPACE:
+		data := &eventv1.HyprRenameWorkspaceValue{}
+		if !s.unmarshal(evt, data) {
+			return 0, true
+		}
+		ws, ok := s.workspaces[int(data.Id)]
+		if !ok {
+			return 0, true
+		}
+		ws.Name = data.Name
+		s.workspaces[ws.ID] = ws
+		for addr, client := range s.clients {
+			if client.Workspace.ID == ws.ID {
+				client.Workspace.Name = data.Name
+				s.clients[addr] = client
+			}
+		}
+		return ChangeWorkspaces | ChangeClients, false
+	case eventv1.EventKind_EVENT_KIND_HYPR_OPENWINDOW:
+		data := &eventv1.HyprOpenWindowValue{}
+		if !s.unmarshal(evt, data) {
+			return 0, true
+		}
+		client := Client{
+			Address:      `0x` + strings.TrimPrefix(data.Address, `0x`),
+			Mapped:       true,
+			Class:        data.Class,
+			Title:        data.Title,
+			InitialClass: data.Class,
+			InitialTitle: data.Title,
+		}
+		client.Workspace.Name = data.WorkspaceName
+		for _, ws := range s.workspaces {
+			if ws.Name == data.WorkspaceName {
+				client.Workspace.ID = ws.ID
+				client.Monitor = ws.MonitorID
+			}
+		}
+		if _, ok := s.clients[client.Address]; !ok {
+			s.order = append(s.order, client.Address)
+		}
+		s.clients[client.Address] = client
+		s.countWindows()
+		// Geometry and process details are not reported, so the resync
+		// completes the client.
+		return ChangeClients | ChangeWorkspaces, true
+	case eventv1.EventKind_EVENT_KIND_HYPR_CLOSEWINDOW:
+		addr, err := eventv1.DataString(evt.Data)
+		if err != nil {
+			return 0, true
+		}
+		if _, ok := s.clients[addr]; !ok {
+			return 0, false
+		}
+		delete(s.clients, addr)
+		s.order = slices.DeleteFunc(s.order, func(a string) bool {
+			return a == addr
+		})
+		s.countWindows()
+		// Remaining tiled clients are resized, which is not reported.
+		return ChangeClients | ChangeWorkspaces, true
+	case eventv1.EventKind_EVENT_KIND_HYPR_MOVEWINDOWV2:
+		data := &eventv1.HyprMoveWindowV2Value{}
+		if !s.unmarshal(evt, data) {
+			return 0, true
+		}
+		client, ok := s.clients[data.Address]
+		if !ok {
+			return 0, true
+		}
+		client.Workspace.ID = int(data.WorkspaceId)
+		client.Workspace.Name = data.WorkspaceName
+		if ws, ok := s.workspaces[client.Workspace.ID]; ok {
+			client.Monitor = ws.MonitorID
+		}
+		s.clients[client.Address] = client
+		s.countWindows()
+		// Geometry on the new workspace, and of the clients tiled alongside
+		// it, is not reported.
+		return ChangeClients | ChangeWorkspaces, true
+	case eventv1.EventKind_EVENT_KIND_HYPR_WINDOWTITLEV2:
+		data := &eventv1.HyprWindowTitleV2Value{}
+		if !s.unmarshal(evt, data) {
+			return 0, true
+		}
+		client, ok := s.clients[data.Address]
+		if !ok {
+			return 0, true
+		}
+		client.Title = data.Title
+		s.clients[client.Address] = client
+		return ChangeClients, false
+	case eventv1.EventKind_EVENT_KIND_HYPR_CHANGEFLOATINGMODE:
+		data := &eventv1.HyprChangeFloatingModeValue{}
+		if !s.unmarshal(evt, data) {
+			return 0, true
+		}
+		client, ok := s.clients[data.Address]
+		if !ok {
+			return 0, true
+		}
+		client.Floating = data.Floating
+		s.clients[client.Address] = client
+		// Geometry changes with floating mode.
+		return ChangeClients, true
+	case eventv1.EventKind_EVENT_KIND_HYPR_PIN:
+		data := &eventv1.HyprPinValue{}
+		if !s.unmarshal(evt, data) {
+			return 0, true
+		}
+		client, ok := s.clients[data.Address]
+		if !ok {
+			return 0, true
+		}
+		client.Pinned = data.Pinned
+		s.clients[client.Address] = client
+		return ChangeClients, false
+	case eventv1.EventKind_EVENT_KIND_HYPR_WINDOWTITLE:
+		// Without windowtitlev2, the title is not reported.
+		return 0, !s.caps.Has(CapabilityWindowTitleV2)
+	case eventv1.EventKind_EVENT_KIND_HYPR_ACTIVESPECIAL:
+		// Without activespecialv2, the workspace ID is not reported.
+		return 0, !s.caps.Has(CapabilityActiveSpecialV2)
+	case eventv1.EventKind_EVENT_KIND_HYPR_FULLSCREEN,
+		eventv1.EventKind_EVENT_KIND_HYPR_TOGGLEGROUP,
+		eventv1.EventKind_EVENT_KIND_HYPR_MOVEINTOGROUP,
+		eventv1.EventKind_EVENT_KIND_HYPR_MOVEOUTOFGROUP,
+		eventv1.EventKind_EVENT_KIND_HYPR_MONITORADDED,
+		eventv1.EventKind_EVENT_KIND_HYPR_MONITORADDEDV2,
+		eventv1.EventKind_EVENT_KIND_HYPR_MONITORREMOVED,
+		eventv1.EventKind_EVENT_KIND_HYPR_MONITORREMOVEDV2,
+		eventv1.EventKind_EVENT_KIND_HYPR_CONFIGRELOADED,
+		eventv1.EventKind_EVENT_KIND_HYPR_RECONNECTED:
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// countWindows recalculates the window count for each workspace.
+func (s *State) countWindows() {
+	counts := make(map[int]int, len(s.workspaces))
+	for _, client := range s.clients {
+		counts[client.Workspace.ID]++
+	}
+	for id, ws := range s.workspaces {
+		ws.Windows = counts[id]
+		s.workspaces[id] = ws
+	}
+}
+
+func (s *State) unmarshal(evt *eventv1.Event, msg proto.Message) bool {
+	if !evt.Data.MessageIs(msg) {
+		s.log.Debug(`Invalid event`, `evt`, evt)
+		return false
+	}
+	if err := evt.Data.UnmarshalTo(msg); err != nil {
+		s.log.Debug(`Invalid event`, `evt`, evt, `err`, err)
+		return false
+	}
+
+	return true
+}
+
+// notify sends changed to all watchers, merging with any change the watcher
+// has not yet received.
+func (s *State) notify(changed Change) {
+	if changed == 0 {
+		return
+	}
+	s.notifyMu.Lock()
+	defer s.notifyMu.Unlock()
+	s.mu.RLock()
+	watchers := slices.Clone(s.watchers)
+	s.mu.RUnlock()
+
+	for _, ch := range watchers {
+		select {
+		case ch <- changed:
+		default:
+			c := changed
+			select {
+			case prev := <-ch:
+				c |= prev
+			default:
+			}
+			ch <- c
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package hypripc_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pdf/hyprpanel/internal/hypripc"
+	"github.com/pdf/hyprpanel/internal/hypripc/hypripctest"
+)
+
+func newState(t *testing.T, h *hypripc.HyprIPC) *hypripc.State {
+	t.Helper()
+	events, cancel := h.Subscribe()
+	t.Cleanup(cancel)
+	s, err := hypripc.NewState(h, hclog.NewNullLogger(), events, time.Hour)
+	if err != nil {
+		t.Fatalf("failed building state: %v", err)
+	}
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// waitChange waits for a change including want, and returns it.
+func waitChange(t *testing.T, ch <-chan hypripc.Change, want hypripc.Change) hypripc.Change {
+	t.Helper()
+	timeout := time.After(eventTimeout)
+	for {
+		select {
+		case changed := <-ch:
+			if changed.Has(want) {
+				return changed
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for change %v", want)
+			return 0
+		}
+	}
+}
+
+func testModel() *hypripctest.Model {
+	model := &hypripctest.Model{
+		Monitors: []hypripc.Monitor{{ID: 0, Name: `DP-1`}},
+		Workspaces: []hypripc.Workspace{
+			{ID: 1, Name: `1`, Monitor: `DP-1`},
+			{ID: 2, Name: `2`, Monitor: `DP-1`},
+		},
+		Clients: []hypripc.Client{{Address: `0xabc`, Class: `foot`, Mapped: true}},
+	}
+	model.Monitors[0].ActiveWorkspace.ID = 1
+	model.Monitors[0].ActiveWorkspace.Name = `1`
+	model.ActiveWorkspace = model.Workspaces[0]
+	model.Clients[0].Workspace.ID = 1
+	model.Clients[0].Workspace.Name = `1`
+
+	return model
+}
+
+func TestStateInitial(t *testing.T) {
+	srv := newServer(t, testModel())
+	h := newClient(t, srv)
+	s := newState(t, h)
+
+	if got := s.Workspaces(); len(got) != 2 {
+		t.Errorf("got %d workspaces, want 2", len(got))
+	}
+	if got := s.ActiveWorkspace(); got.ID != 1 {
+		t.Errorf("got active workspace %d, want 1", got.ID)
+	}
+	if _, ok := s.Client(`0xabc`); !ok {
+		t.Error(`missing client 0xabc`)
+	}
+}
+
+func TestStateWorkspace(t *testing.T) {
+	srv := newServer(t, testModel())
+	h := newClient(t, srv)
+	s := newState(t, h)
+	ch, cancel := s.Watch()
+	defer cancel()
+
+	if err := h.Dispatch(hypripc.DispatchWorkspace, `2`); err != nil {
+		t.Fatal(err)
+	}
+	waitChange(t, ch, hypripc.ChangeActive)
+	if got := s.ActiveWorkspace(); got.ID != 2 {
+		t.Errorf("got active workspace %d, want 2", got.ID)
+	}
+}
+
+func TestStateClients(t *testing.T) {
+	srv := newServer(t, testModel())
+	h := newClient(t, srv)
+	s := newState(t, h)
+	ch, cancel := s.Watch()
+	defer cancel()
+
+	// Keep the model consistent with the events, so that the refresh
+	// following openwindow does not discard the client.
+	srv.Update(func(m *hypripctest.Model) {
+		c := hypripc.Client{Address: `0xdef`, Class: `firefox`, Title: `web`, Mapped: true}
+		c.Workspace.ID = 2
+		c.Workspace.Name = `2`
+		m.Clients = append(m.Clients, c)
+	})
+	if err := srv.Emit(hypripc.EventOpenWindow, `def,2,firefox,web`); err != nil {
+		t.Fatal(err)
+	}
+	waitChange(t, ch, hypripc.ChangeClients)
+	client, ok := s.Client(`0xdef`)
+	if !ok {
+		t.Fatal(`missing opened client 0xdef`)
+	}
+	if client.Workspace.ID != 2 || client.Class != `firefox` {
+		t.Errorf("unexpected client: %+v", client)
+	}
+
+	srv.Update(func(m *hypripctest.Model) {
+		m.Clients[1].Workspace.ID = 1
+		m.Clients[1].Workspace.Name = `1`
+	})
+	if err := srv.Emit(hypripc.EventMoveWindowV2, `def,1,1`); err != nil {
+		t.Fatal(err)
+	}
+	waitChange(t, ch, hypripc.ChangeClients)
+	if client, _ := s.Client(`0xdef`); client.Workspace.ID != 1 {
+		t.Errorf("moved client on workspace %d, want 1", client.Workspace.ID)
+	}
+
+	srv.Update(func(m *hypripctest.Model) {
+		m.Clients = m.Clients[:1]
+	})
+	if err := srv.Emit(hypripc.EventCloseWindow, `def`); err != nil {
+		t.Fatal(err)
+	}
+	waitChange(t, ch, hypripc.ChangeClients)
+	if _, ok := s.Client(`0xdef`); ok {
+		t.Error(`closed client 0xdef still present`)
+	}
+	if got := s.Clients(); len(got) != 1 {
+		t.Errorf("got %d clients, want 1", len(got))
+	}
+}
+
+func TestStateCloseWindowResync(t *testing.T) {
+	model := testModel()
+	c := hypripc.Client{Address: `0xdef`, Class: `firefox`, Mapped: true}
+	c.Workspace.ID = 1
+	c.Workspace.Name = `1`
+	model.Clients = append(model.Clients, c)
+	srv := newServer(t, model)
+	h := newClient(t, srv)
+	s := newState(t, h)
+	ch, cancel := s.Watch()
+	defer cancel()
+
+	// The remaining client is retiled, which is only picked up by a resync.
+	srv.Update(func(m *hypripctest.Model) {
+		m.Clients = m.Clients[:1]
+		m.Clients[0].Size = []int{1920, 1080}
+	})
+	if err := srv.Emit(hypripc.EventCloseWindow, `def`); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		waitChange(t, ch, hypripc.ChangeClients)
+		if client, _ := s.Client(`0xabc`); slices.Equal(client.Size, []int{1920, 1080}) {
+			break
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package hypripc
+
+// Version of the running Hyprland instance.
+type Version struct {
+	Branch          string   `json:"branch"`
+	Commit          string   `json:"commit"`
+	Version         string   `json:"version"`
+	Dirty           bool     `json:"dirty"`
+	CommitMessage   string   `json:"commit_message"`
+	CommitDate      string   `json:"commit_date"`
+	Tag             string   `json:"tag"`
+	Commits         string   `json:"commits"`
+	BuildAquamarine string   `json:"buildAquamarine"`
+	Flags           []string `json:"flags"`
+}
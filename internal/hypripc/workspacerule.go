@@ -0,0 +1,18 @@
+package hypripc
+
+// WorkspaceRule container. Rules only include the properties they set.
+type WorkspaceRule struct {
+	WorkspaceString   string `json:"workspaceString"`
+	Monitor           string `json:"monitor"`
+	Default           bool   `json:"default"`
+	Persistent        bool   `json:"persistent"`
+	GapsIn            []int  `json:"gapsIn"`
+	GapsOut           []int  `json:"gapsOut"`
+	BorderSize        int    `json:"borderSize"`
+	Border            bool   `json:"border"`
+	Rounding          bool   `json:"rounding"`
+	Decorate          bool   `json:"decorate"`
+	Shadow            bool   `json:"shadow"`
+	OnCreatedEmptyCmd string `json:"on-created-empty"`
+	DefaultName       string `json:"defaultName"`
+}
@@ -2,6 +2,9 @@ package panelplugin
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"math"
 	"time"
 
@@ -18,10 +21,11 @@ type PanelGRPCClient struct {
 	client hyprpanelv1.PanelServiceClient
 	server *grpc.Server
 	ctx    context.Context
+	events *eventStream
 }
 
 // Init implementation.
-func (c *PanelGRPCClient) Init(h Host, id string, loglevel configv1.LogLevel, config *configv1.Panel, stylesheet []byte) error {
+func (c *PanelGRPCClient) Init(h Host, id string, loglevel configv1.LogLevel, config *configv1.Panel, stylesheet []byte, hyprSnapshot *eventv1.HyprSnapshotValue) ([]eventv1.EventKind, bool, error) {
 	host := &HostGRPCServer{Impl: h}
 	serverFunc := func(opts []grpc.ServerOption) *grpc.Server {
 		opts = append(opts, grpc.MaxRecvMsgSize(math.MaxInt32))
@@ -34,30 +38,65 @@ func (c *PanelGRPCClient) Init(h Host, id string, loglevel configv1.LogLevel, co
 	hostRef := c.broker.NextId()
 	go c.broker.AcceptAndServe(hostRef, serverFunc)
 
-	_, err := c.client.Init(context.Background(), &hyprpanelv1.PanelServiceInitRequest{
-		Host:       hostRef,
-		Id:         id,
-		LogLevel:   loglevel,
-		Config:     config,
-		Stylesheet: stylesheet,
+	res, err := c.client.Init(context.Background(), &hyprpanelv1.PanelServiceInitRequest{
+		Host:         hostRef,
+		Id:           id,
+		LogLevel:     loglevel,
+		Config:       config,
+		Stylesheet:   stylesheet,
+		HyprSnapshot: hyprSnapshot,
 	})
+	if err != nil {
+		return nil, false, err
+	}
 
-	return err
+	c.events, err = newEventStream(c.ctx, c.client)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res.Kinds, res.FilterEvents, nil
 }
 
 // Notify implementation.
-func (c *PanelGRPCClient) Notify(evt *eventv1.Event) {
-	_, _ = c.client.Notify(context.Background(), &hyprpanelv1.PanelServiceNotifyRequest{Event: evt})
+func (c *PanelGRPCClient) Notify(evt *eventv1.Event) error {
+	return c.events.notify(evt)
+}
+
+// SetVisible implementation.
+func (c *PanelGRPCClient) SetVisible(visible bool) error {
+	_, err := c.client.SetVisible(context.Background(), &hyprpanelv1.PanelServiceSetVisibleRequest{Visible: visible})
+	return err
+}
+
+// UpdateStyle implementation.
+func (c *PanelGRPCClient) UpdateStyle(stylesheet []byte) error {
+	_, err := c.client.UpdateStyle(context.Background(), &hyprpanelv1.PanelServiceUpdateStyleRequest{Stylesheet: stylesheet})
+	return err
+}
+
+// SetLogLevel implementation.
+func (c *PanelGRPCClient) SetLogLevel(loglevel configv1.LogLevel) error {
+	_, err := c.client.SetLogLevel(context.Background(), &hyprpanelv1.PanelServiceSetLogLevelRequest{LogLevel: loglevel})
+	return err
 }
 
-// Context implementation.
+// Context implementation, the context is cancelled when the plugin exits or
+// the event stream fails.
 func (c *PanelGRPCClient) Context() context.Context {
-	return c.ctx
+	if c.events == nil {
+		return c.ctx
+	}
+
+	return c.events.ctx
 }
 
 // Close implementation.
 func (c *PanelGRPCClient) Close() {
 	defer c.server.Stop()
+	if c.events != nil {
+		c.events.close()
+	}
 	_, _ = c.client.Close(context.Background(), &hyprpanelv1.PanelServiceCloseRequest{})
 }
 
@@ -79,18 +118,68 @@ func (s *PanelGRPCServer) Init(_ context.Context, req *hyprpanelv1.PanelServiceI
 	}
 
 	host := &HostGRPCClient{client: hyprpanelv1.NewHostServiceClient(s.conn)}
-	if err := s.Impl.Init(host, req.Id, req.LogLevel, req.Config, req.Stylesheet); err != nil {
+	kinds, filter, err := s.Impl.Init(host, req.Id, req.LogLevel, req.Config, req.Stylesheet, req.HyprSnapshot)
+	if err != nil {
 		return &hyprpanelv1.PanelServiceInitResponse{}, err
 	}
 
-	return &hyprpanelv1.PanelServiceInitResponse{}, nil
+	return &hyprpanelv1.PanelServiceInitResponse{Kinds: kinds, FilterEvents: filter}, nil
+}
+
+// Events implementation, each batch is acknowledged after its events have
+// been delivered.
+func (s *PanelGRPCServer) Events(stream hyprpanelv1.PanelService_EventsServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, evt := range req.Events {
+			if err := s.Impl.Notify(evt); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", evt.Kind, err))
+			}
+		}
+
+		res := &hyprpanelv1.PanelServiceEventsResponse{Seq: req.Seq}
+		if err := errors.Join(errs...); err != nil {
+			res.Error = err.Error()
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
 }
 
-// Notify implementation.
-func (s *PanelGRPCServer) Notify(_ context.Context, req *hyprpanelv1.PanelServiceNotifyRequest) (*hyprpanelv1.PanelServiceNotifyResponse, error) {
-	s.Impl.Notify(req.Event)
+// SetVisible implementation.
+func (s *PanelGRPCServer) SetVisible(_ context.Context, req *hyprpanelv1.PanelServiceSetVisibleRequest) (*hyprpanelv1.PanelServiceSetVisibleResponse, error) {
+	if err := s.Impl.SetVisible(req.Visible); err != nil {
+		return &hyprpanelv1.PanelServiceSetVisibleResponse{}, err
+	}
+
+	return &hyprpanelv1.PanelServiceSetVisibleResponse{}, nil
+}
+
+// UpdateStyle implementation.
+func (s *PanelGRPCServer) UpdateStyle(_ context.Context, req *hyprpanelv1.PanelServiceUpdateStyleRequest) (*hyprpanelv1.PanelServiceUpdateStyleResponse, error) {
+	if err := s.Impl.UpdateStyle(req.Stylesheet); err != nil {
+		return &hyprpanelv1.PanelServiceUpdateStyleResponse{}, err
+	}
+
+	return &hyprpanelv1.PanelServiceUpdateStyleResponse{}, nil
+}
+
+// SetLogLevel implementation.
+func (s *PanelGRPCServer) SetLogLevel(_ context.Context, req *hyprpanelv1.PanelServiceSetLogLevelRequest) (*hyprpanelv1.PanelServiceSetLogLevelResponse, error) {
+	if err := s.Impl.SetLogLevel(req.LogLevel); err != nil {
+		return &hyprpanelv1.PanelServiceSetLogLevelResponse{}, err
+	}
 
-	return &hyprpanelv1.PanelServiceNotifyResponse{}, nil
+	return &hyprpanelv1.PanelServiceSetLogLevelResponse{}, nil
 }
 
 // Close implmenetation.
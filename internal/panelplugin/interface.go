@@ -21,7 +21,7 @@ const (
 
 // Handshake default parameters.
 var Handshake = plugin.HandshakeConfig{
-	ProtocolVersion:  1,
+	ProtocolVersion:  2,
 	MagicCookieKey:   `hyprpanel`,
 	MagicCookieValue: `panel`,
 }
@@ -53,8 +53,18 @@ type Host interface {
 
 // Panel interface.
 type Panel interface {
-	Init(host Host, id string, loglevel configv1.LogLevel, config *configv1.Panel, stylesheet []byte) error
-	Notify(evt *eventv1.Event)
+	// Init initializes the panel. When filter is set, only events of the
+	// returned kinds are delivered to the panel, otherwise all events are
+	// delivered. When hyprSnapshot is set, the panel answers Hyprland queries
+	// from it rather than connecting to Hyprland.
+	Init(host Host, id string, loglevel configv1.LogLevel, config *configv1.Panel, stylesheet []byte, hyprSnapshot *eventv1.HyprSnapshotValue) (kinds []eventv1.EventKind, filter bool, err error)
+	// Notify delivers evt to the panel, and returns delivery errors that have
+	// been reported since the previous call.
+	Notify(evt *eventv1.Event) error
+	SetVisible(visible bool) error
+	UpdateStyle(stylesheet []byte) error
+	// SetLogLevel changes the log level of the running panel.
+	SetLogLevel(loglevel configv1.LogLevel) error
 	Context() context.Context
 	Close()
 }
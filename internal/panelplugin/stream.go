@@ -0,0 +1,171 @@
+package panelplugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+)
+
+const (
+	// eventBatchSize is the maximum number of events sent in a single batch.
+	eventBatchSize = 64
+	// eventWindow is the maximum number of unacknowledged batches, Notify
+	// blocks once the window and queue are full.
+	eventWindow = 8
+	// eventAckTimeout is how long a batch may remain unacknowledged before the
+	// panel is considered dead.
+	eventAckTimeout = 5 * time.Second
+)
+
+var errPanelNotResponding = errors.New(`panel not responding`)
+
+// eventStream delivers events to a panel in ordered batches over the
+// PanelService.Events stream. The stream context is cancelled when the stream
+// fails, or a batch is not acknowledged within eventAckTimeout.
+type eventStream struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	stream hyprpanelv1.PanelService_EventsClient
+	queue  chan *eventv1.Event
+	window chan struct{}
+
+	// pending holds the send time of each unacknowledged batch, in order.
+	pending []time.Time
+	err     error
+	mu      sync.Mutex
+}
+
+// notify queues evt for delivery, and returns any delivery error reported by
+// the panel since the previous call.
+func (s *eventStream) notify(evt *eventv1.Event) error {
+	select {
+	case s.queue <- evt:
+	case <-s.ctx.Done():
+		return context.Cause(s.ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := s.err
+	s.err = nil
+
+	return err
+}
+
+func (s *eventStream) close() {
+	_ = s.stream.CloseSend()
+	s.cancel(context.Canceled)
+}
+
+// send batches queued events, waiting for space in the window before sending
+// each batch.
+func (s *eventStream) send() {
+	var seq uint64
+	for {
+		var batch []*eventv1.Event
+		select {
+		case <-s.ctx.Done():
+			return
+		case evt := <-s.queue:
+			batch = append(make([]*eventv1.Event, 0, eventBatchSize), evt)
+		}
+	drain:
+		for len(batch) < eventBatchSize {
+			select {
+			case evt := <-s.queue:
+				batch = append(batch, evt)
+			default:
+				break drain
+			}
+		}
+
+		select {
+		case s.window <- struct{}{}:
+		case <-s.ctx.Done():
+			return
+		}
+
+		seq++
+		s.mu.Lock()
+		s.pending = append(s.pending, time.Now())
+		s.mu.Unlock()
+		if err := s.stream.Send(&hyprpanelv1.PanelServiceEventsRequest{Seq: seq, Events: batch}); err != nil {
+			s.cancel(fmt.Errorf(`failed sending events: %w`, err))
+			return
+		}
+	}
+}
+
+// recv processes acknowledgements, releasing space in the window.
+func (s *eventStream) recv() {
+	for {
+		res, err := s.stream.Recv()
+		if err != nil {
+			s.cancel(fmt.Errorf(`event stream closed: %w`, err))
+			return
+		}
+
+		s.mu.Lock()
+		if len(s.pending) > 0 {
+			s.pending = s.pending[1:]
+		}
+		if res.Error != `` {
+			s.err = fmt.Errorf(`panel failed handling events in batch %d: %s`, res.Seq, res.Error)
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-s.window:
+		default:
+		}
+	}
+}
+
+// watchdog cancels the stream if the oldest batch is not acknowledged within
+// eventAckTimeout.
+func (s *eventStream) watchdog() {
+	ticker := time.NewTicker(eventAckTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			stalled := len(s.pending) > 0 && time.Since(s.pending[0]) > eventAckTimeout
+			s.mu.Unlock()
+			if stalled {
+				s.cancel(errPanelNotResponding)
+				return
+			}
+		}
+	}
+}
+
+func newEventStream(ctx context.Context, client hyprpanelv1.PanelServiceClient) (*eventStream, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	stream, err := client.Events(ctx)
+	if err != nil {
+		cancel(err)
+		return nil, fmt.Errorf(`failed opening event stream: %w`, err)
+	}
+
+	s := &eventStream{
+		ctx:    ctx,
+		cancel: cancel,
+		stream: stream,
+		queue:  make(chan *eventv1.Event, eventBatchSize),
+		window: make(chan struct{}, eventWindow),
+	}
+	go s.send()
+	go s.recv()
+	go s.watchdog()
+
+	return s, nil
+}
@@ -0,0 +1,241 @@
+// Package main provides a protoc plugin that generates a JSON Schema for a protobuf message, as encoded by protojson
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	schemaDialect  = `https://json-schema.org/draft/2020-12/schema`
+	durationName   = `google.protobuf.Duration`
+	durationFormat = `^-?[0-9]+(\.[0-9]{0,9})?s$`
+)
+
+// property is a named schema, properties are encoded in declaration order.
+type property struct {
+	name   string
+	schema *schema
+}
+
+type properties []property
+
+// MarshalJSON implements json.Marshaler.
+func (p properties) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, prop := range p {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(prop.name)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(prop.schema)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+type schema struct {
+	Schema               string     `json:"$schema,omitempty"`
+	Ref                  string     `json:"$ref,omitempty"`
+	Title                string     `json:"title,omitempty"`
+	Description          string     `json:"description,omitempty"`
+	Deprecated           bool       `json:"deprecated,omitempty"`
+	Type                 any        `json:"type,omitempty"`
+	Enum                 []string   `json:"enum,omitempty"`
+	Pattern              string     `json:"pattern,omitempty"`
+	Minimum              *int64     `json:"minimum,omitempty"`
+	Items                *schema    `json:"items,omitempty"`
+	Properties           properties `json:"properties,omitempty"`
+	AdditionalProperties any        `json:"additionalProperties,omitempty"`
+	OneOf                []*schema  `json:"oneOf,omitempty"`
+	AllOf                []*schema  `json:"allOf,omitempty"`
+	Required             []string   `json:"required,omitempty"`
+	Defs                 properties `json:"$defs,omitempty"`
+}
+
+type generator struct {
+	defs properties
+	seen map[protoreflect.FullName]struct{}
+}
+
+func comment(c protogen.CommentSet) string {
+	s := string(c.Trailing)
+	if s == `` {
+		s = string(c.Leading)
+	}
+
+	return strings.TrimSpace(strings.Join(strings.Fields(s), ` `))
+}
+
+func defName(name protoreflect.FullName) string {
+	return string(name)
+}
+
+// fieldNames returns the names accepted by protojson for field, the proto name
+// followed by the JSON name if it differs.
+func fieldNames(field *protogen.Field) []string {
+	names := []string{string(field.Desc.Name())}
+	if json := field.Desc.JSONName(); json != names[0] {
+		names = append(names, json)
+	}
+
+	return names
+}
+
+func (g *generator) ref(msg *protogen.Message) *schema {
+	name := msg.Desc.FullName()
+	if _, ok := g.seen[name]; !ok {
+		g.seen[name] = struct{}{}
+		def := g.message(msg)
+		g.defs = append(g.defs, property{name: defName(name), schema: def})
+	}
+
+	return &schema{Ref: `#/$defs/` + defName(name)}
+}
+
+func (g *generator) message(msg *protogen.Message) *schema {
+	s := &schema{
+		Type:                 `object`,
+		Description:          comment(msg.Comments),
+		AdditionalProperties: false,
+	}
+
+	for _, field := range msg.Fields {
+		fs := g.field(field)
+		fs.Description = comment(field.Comments)
+		if opts := field.Desc.Options(); opts != nil {
+			if fo, ok := opts.(interface{ GetDeprecated() bool }); ok && fo.GetDeprecated() {
+				fs.Deprecated = true
+			}
+		}
+		for _, name := range fieldNames(field) {
+			s.Properties = append(s.Properties, property{name: name, schema: fs})
+		}
+	}
+
+	var oneofs []*schema
+	for _, oneof := range msg.Oneofs {
+		if oneof.Desc.IsSynthetic() {
+			continue
+		}
+		o := &schema{Description: comment(oneof.Comments)}
+		for _, field := range oneof.Fields {
+			for _, name := range fieldNames(field) {
+				o.OneOf = append(o.OneOf, &schema{Required: []string{name}})
+			}
+		}
+		oneofs = append(oneofs, o)
+	}
+	switch len(oneofs) {
+	case 0:
+	case 1:
+		s.OneOf = oneofs[0].OneOf
+	default:
+		s.AllOf = oneofs
+	}
+
+	return s
+}
+
+func (g *generator) field(field *protogen.Field) *schema {
+	if field.Desc.IsMap() {
+		return &schema{
+			Type:                 `object`,
+			AdditionalProperties: g.scalar(field.Message.Fields[1]),
+		}
+	}
+	s := g.scalar(field)
+	if field.Desc.IsList() {
+		return &schema{Type: `array`, Items: s}
+	}
+
+	return s
+}
+
+func (g *generator) scalar(field *protogen.Field) *schema {
+	zero := int64(0)
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return &schema{Type: `boolean`}
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return &schema{Type: `string`}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &schema{Type: `integer`}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &schema{Type: `integer`, Minimum: &zero}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &schema{Type: []string{`integer`, `string`}}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return &schema{Type: `number`}
+	case protoreflect.EnumKind:
+		s := &schema{Type: `string`, Description: comment(field.Enum.Comments)}
+		for _, v := range field.Enum.Values {
+			s.Enum = append(s.Enum, string(v.Desc.Name()))
+		}
+		return s
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if field.Message.Desc.FullName() == durationName {
+			return &schema{Type: `string`, Pattern: durationFormat}
+		}
+		return g.ref(field.Message)
+	default:
+		return &schema{}
+	}
+}
+
+func main() {
+	var flags flag.FlagSet
+	root := flags.String(`root`, ``, `fully-qualified name of the root message`)
+	out := flags.String(`out`, `schema.json`, `output file name`)
+	title := flags.String(`title`, ``, `schema title`)
+
+	protogen.Options{ParamFunc: flags.Set}.Run(func(plugin *protogen.Plugin) error {
+		var msg *protogen.Message
+		for _, f := range plugin.Files {
+			for _, m := range f.Messages {
+				if string(m.Desc.FullName()) == *root {
+					msg = m
+				}
+			}
+		}
+		if msg == nil {
+			return fmt.Errorf("root message not found: %q", *root)
+		}
+
+		g := &generator{seen: make(map[protoreflect.FullName]struct{})}
+		s := g.message(msg)
+		s.Schema = schemaDialect
+		s.Title = *title
+		// Allow editors to associate the schema with a config file.
+		s.Properties = append(properties{{name: `$schema`, schema: &schema{Type: `string`}}}, s.Properties...)
+		s.Defs = g.defs
+
+		b, err := json.MarshalIndent(s, ``, "\t")
+		if err != nil {
+			return err
+		}
+		gf := plugin.NewGeneratedFile(*out, ``)
+		if _, err := gf.Write(append(b, '\n')); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
@@ -12,6 +12,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -262,6 +263,17 @@ const (
 	EventKind_EVENT_KIND_HYPR_DESTROYWORKSPACEV2       EventKind = 57
 	EventKind_EVENT_KIND_HYPR_WORKSPACEV2              EventKind = 58
 	EventKind_EVENT_KIND_EXEC                          EventKind = 59
+	EventKind_EVENT_KIND_HYPR_RECONNECTED              EventKind = 60
+	EventKind_EVENT_KIND_HYPR_WINDOWTITLEV2            EventKind = 61
+	EventKind_EVENT_KIND_HYPR_ACTIVESPECIALV2          EventKind = 62
+	EventKind_EVENT_KIND_HYPR_PIN                      EventKind = 63
+	EventKind_EVENT_KIND_HYPR_CONFIGRELOADED           EventKind = 64
+	EventKind_EVENT_KIND_HYPR_BELL                     EventKind = 65
+	EventKind_EVENT_KIND_HYPR_TOGGLEGROUP              EventKind = 66
+	EventKind_EVENT_KIND_HYPR_MOVEINTOGROUP            EventKind = 67
+	EventKind_EVENT_KIND_HYPR_MOVEOUTOFGROUP           EventKind = 68
+	EventKind_EVENT_KIND_HYPR_MONITORADDEDV2           EventKind = 69
+	EventKind_EVENT_KIND_HYPR_MONITORREMOVEDV2         EventKind = 70
 )
 
 // Enum value maps for EventKind.
@@ -326,6 +338,17 @@ var (
 		57: "EVENT_KIND_HYPR_DESTROYWORKSPACEV2",
 		58: "EVENT_KIND_HYPR_WORKSPACEV2",
 		59: "EVENT_KIND_EXEC",
+		60: "EVENT_KIND_HYPR_RECONNECTED",
+		61: "EVENT_KIND_HYPR_WINDOWTITLEV2",
+		62: "EVENT_KIND_HYPR_ACTIVESPECIALV2",
+		63: "EVENT_KIND_HYPR_PIN",
+		64: "EVENT_KIND_HYPR_CONFIGRELOADED",
+		65: "EVENT_KIND_HYPR_BELL",
+		66: "EVENT_KIND_HYPR_TOGGLEGROUP",
+		67: "EVENT_KIND_HYPR_MOVEINTOGROUP",
+		68: "EVENT_KIND_HYPR_MOVEOUTOFGROUP",
+		69: "EVENT_KIND_HYPR_MONITORADDEDV2",
+		70: "EVENT_KIND_HYPR_MONITORREMOVEDV2",
 	}
 	EventKind_value = map[string]int32{
 		"EVENT_KIND_UNSPECIFIED":                   0,
@@ -387,6 +410,17 @@ var (
 		"EVENT_KIND_HYPR_DESTROYWORKSPACEV2":       57,
 		"EVENT_KIND_HYPR_WORKSPACEV2":              58,
 		"EVENT_KIND_EXEC":                          59,
+		"EVENT_KIND_HYPR_RECONNECTED":              60,
+		"EVENT_KIND_HYPR_WINDOWTITLEV2":            61,
+		"EVENT_KIND_HYPR_ACTIVESPECIALV2":          62,
+		"EVENT_KIND_HYPR_PIN":                      63,
+		"EVENT_KIND_HYPR_CONFIGRELOADED":           64,
+		"EVENT_KIND_HYPR_BELL":                     65,
+		"EVENT_KIND_HYPR_TOGGLEGROUP":              66,
+		"EVENT_KIND_HYPR_MOVEINTOGROUP":            67,
+		"EVENT_KIND_HYPR_MOVEOUTOFGROUP":           68,
+		"EVENT_KIND_HYPR_MONITORADDEDV2":           69,
+		"EVENT_KIND_HYPR_MONITORREMOVEDV2":         70,
 	}
 )
 
@@ -999,6 +1033,995 @@ func (x *HyprOpenWindowValue) GetTitle() string {
 	return ""
 }
 
+type HyprActiveWindowV2Value struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // address of the active window, with 0x prefix, empty if no window is active.
+}
+
+func (x *HyprActiveWindowV2Value) Reset() {
+	*x = HyprActiveWindowV2Value{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprActiveWindowV2Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprActiveWindowV2Value) ProtoMessage() {}
+
+func (x *HyprActiveWindowV2Value) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprActiveWindowV2Value.ProtoReflect.Descriptor instead.
+func (*HyprActiveWindowV2Value) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HyprActiveWindowV2Value) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type HyprFullscreenValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fullscreen bool `protobuf:"varint,1,opt,name=fullscreen,proto3" json:"fullscreen,omitempty"` // whether fullscreen mode was entered.
+}
+
+func (x *HyprFullscreenValue) Reset() {
+	*x = HyprFullscreenValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprFullscreenValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprFullscreenValue) ProtoMessage() {}
+
+func (x *HyprFullscreenValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprFullscreenValue.ProtoReflect.Descriptor instead.
+func (*HyprFullscreenValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HyprFullscreenValue) GetFullscreen() bool {
+	if x != nil {
+		return x.Fullscreen
+	}
+	return false
+}
+
+type HyprUrgentValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // address of the window requesting attention, with 0x prefix.
+}
+
+func (x *HyprUrgentValue) Reset() {
+	*x = HyprUrgentValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprUrgentValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprUrgentValue) ProtoMessage() {}
+
+func (x *HyprUrgentValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprUrgentValue.ProtoReflect.Descriptor instead.
+func (*HyprUrgentValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *HyprUrgentValue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type HyprMinimizeValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`      // address of the window, with 0x prefix.
+	Minimized bool   `protobuf:"varint,2,opt,name=minimized,proto3" json:"minimized,omitempty"` // whether the window requested to be minimized.
+}
+
+func (x *HyprMinimizeValue) Reset() {
+	*x = HyprMinimizeValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprMinimizeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprMinimizeValue) ProtoMessage() {}
+
+func (x *HyprMinimizeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprMinimizeValue.ProtoReflect.Descriptor instead.
+func (*HyprMinimizeValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HyprMinimizeValue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *HyprMinimizeValue) GetMinimized() bool {
+	if x != nil {
+		return x.Minimized
+	}
+	return false
+}
+
+type HyprScreencastValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active      bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`                              // whether screencasting started.
+	WindowShare bool `protobuf:"varint,2,opt,name=window_share,json=windowShare,proto3" json:"window_share,omitempty"` // whether a window is being shared, otherwise a monitor.
+}
+
+func (x *HyprScreencastValue) Reset() {
+	*x = HyprScreencastValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprScreencastValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprScreencastValue) ProtoMessage() {}
+
+func (x *HyprScreencastValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprScreencastValue.ProtoReflect.Descriptor instead.
+func (*HyprScreencastValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HyprScreencastValue) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *HyprScreencastValue) GetWindowShare() bool {
+	if x != nil {
+		return x.WindowShare
+	}
+	return false
+}
+
+type HyprChangeFloatingModeValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`    // address of the window, with 0x prefix.
+	Floating bool   `protobuf:"varint,2,opt,name=floating,proto3" json:"floating,omitempty"` // whether the window is now floating.
+}
+
+func (x *HyprChangeFloatingModeValue) Reset() {
+	*x = HyprChangeFloatingModeValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprChangeFloatingModeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprChangeFloatingModeValue) ProtoMessage() {}
+
+func (x *HyprChangeFloatingModeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprChangeFloatingModeValue.ProtoReflect.Descriptor instead.
+func (*HyprChangeFloatingModeValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HyprChangeFloatingModeValue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *HyprChangeFloatingModeValue) GetFloating() bool {
+	if x != nil {
+		return x.Floating
+	}
+	return false
+}
+
+type HyprWindowTitleValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // address of the window, with 0x prefix.
+}
+
+func (x *HyprWindowTitleValue) Reset() {
+	*x = HyprWindowTitleValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprWindowTitleValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprWindowTitleValue) ProtoMessage() {}
+
+func (x *HyprWindowTitleValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprWindowTitleValue.ProtoReflect.Descriptor instead.
+func (*HyprWindowTitleValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HyprWindowTitleValue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type HyprOpenLayerValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // namespace of the layer surface.
+}
+
+func (x *HyprOpenLayerValue) Reset() {
+	*x = HyprOpenLayerValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprOpenLayerValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprOpenLayerValue) ProtoMessage() {}
+
+func (x *HyprOpenLayerValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprOpenLayerValue.ProtoReflect.Descriptor instead.
+func (*HyprOpenLayerValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HyprOpenLayerValue) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type HyprCloseLayerValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // namespace of the layer surface.
+}
+
+func (x *HyprCloseLayerValue) Reset() {
+	*x = HyprCloseLayerValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprCloseLayerValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprCloseLayerValue) ProtoMessage() {}
+
+func (x *HyprCloseLayerValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprCloseLayerValue.ProtoReflect.Descriptor instead.
+func (*HyprCloseLayerValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HyprCloseLayerValue) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type HyprSubmapValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // name of the active submap, empty for the default submap.
+}
+
+func (x *HyprSubmapValue) Reset() {
+	*x = HyprSubmapValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprSubmapValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprSubmapValue) ProtoMessage() {}
+
+func (x *HyprSubmapValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprSubmapValue.ProtoReflect.Descriptor instead.
+func (*HyprSubmapValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HyprSubmapValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type HyprWindowTitleV2Value struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // address of the window, with 0x prefix.
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`     // new window title.
+}
+
+func (x *HyprWindowTitleV2Value) Reset() {
+	*x = HyprWindowTitleV2Value{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprWindowTitleV2Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprWindowTitleV2Value) ProtoMessage() {}
+
+func (x *HyprWindowTitleV2Value) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprWindowTitleV2Value.ProtoReflect.Descriptor instead.
+func (*HyprWindowTitleV2Value) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HyprWindowTitleV2Value) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *HyprWindowTitleV2Value) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type HyprActiveSpecialV2Value struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`          // ID of the special workspace, zero when the special workspace was closed.
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`       // name of the special workspace, empty when the special workspace was closed.
+	Monitor string `protobuf:"bytes,3,opt,name=monitor,proto3" json:"monitor,omitempty"` // name of the monitor.
+}
+
+func (x *HyprActiveSpecialV2Value) Reset() {
+	*x = HyprActiveSpecialV2Value{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprActiveSpecialV2Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprActiveSpecialV2Value) ProtoMessage() {}
+
+func (x *HyprActiveSpecialV2Value) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprActiveSpecialV2Value.ProtoReflect.Descriptor instead.
+func (*HyprActiveSpecialV2Value) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *HyprActiveSpecialV2Value) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HyprActiveSpecialV2Value) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HyprActiveSpecialV2Value) GetMonitor() string {
+	if x != nil {
+		return x.Monitor
+	}
+	return ""
+}
+
+type HyprPinValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // address of the window, with 0x prefix.
+	Pinned  bool   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`  // whether the window is now pinned.
+}
+
+func (x *HyprPinValue) Reset() {
+	*x = HyprPinValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprPinValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprPinValue) ProtoMessage() {}
+
+func (x *HyprPinValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprPinValue.ProtoReflect.Descriptor instead.
+func (*HyprPinValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HyprPinValue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *HyprPinValue) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type HyprBellValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // address of the window that rang the bell, with 0x prefix, may be empty.
+}
+
+func (x *HyprBellValue) Reset() {
+	*x = HyprBellValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprBellValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprBellValue) ProtoMessage() {}
+
+func (x *HyprBellValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprBellValue.ProtoReflect.Descriptor instead.
+func (*HyprBellValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HyprBellValue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type HyprToggleGroupValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Open      bool     `protobuf:"varint,1,opt,name=open,proto3" json:"open,omitempty"`          // whether the group was created, otherwise destroyed.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"` // addresses of the windows in the group, with 0x prefix.
+}
+
+func (x *HyprToggleGroupValue) Reset() {
+	*x = HyprToggleGroupValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprToggleGroupValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprToggleGroupValue) ProtoMessage() {}
+
+func (x *HyprToggleGroupValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprToggleGroupValue.ProtoReflect.Descriptor instead.
+func (*HyprToggleGroupValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *HyprToggleGroupValue) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *HyprToggleGroupValue) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type HyprMoveIntoGroupValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // address of the window, with 0x prefix.
+}
+
+func (x *HyprMoveIntoGroupValue) Reset() {
+	*x = HyprMoveIntoGroupValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprMoveIntoGroupValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprMoveIntoGroupValue) ProtoMessage() {}
+
+func (x *HyprMoveIntoGroupValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprMoveIntoGroupValue.ProtoReflect.Descriptor instead.
+func (*HyprMoveIntoGroupValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *HyprMoveIntoGroupValue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type HyprMoveOutOfGroupValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // address of the window, with 0x prefix.
+}
+
+func (x *HyprMoveOutOfGroupValue) Reset() {
+	*x = HyprMoveOutOfGroupValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprMoveOutOfGroupValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprMoveOutOfGroupValue) ProtoMessage() {}
+
+func (x *HyprMoveOutOfGroupValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprMoveOutOfGroupValue.ProtoReflect.Descriptor instead.
+func (*HyprMoveOutOfGroupValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HyprMoveOutOfGroupValue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type HyprMonitorAddedV2Value struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                  // monitor ID.
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // monitor name.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // monitor description.
+}
+
+func (x *HyprMonitorAddedV2Value) Reset() {
+	*x = HyprMonitorAddedV2Value{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprMonitorAddedV2Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprMonitorAddedV2Value) ProtoMessage() {}
+
+func (x *HyprMonitorAddedV2Value) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprMonitorAddedV2Value.ProtoReflect.Descriptor instead.
+func (*HyprMonitorAddedV2Value) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HyprMonitorAddedV2Value) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HyprMonitorAddedV2Value) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HyprMonitorAddedV2Value) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type HyprMonitorRemovedV2Value struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                  // monitor ID.
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // monitor name.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // monitor description.
+}
+
+func (x *HyprMonitorRemovedV2Value) Reset() {
+	*x = HyprMonitorRemovedV2Value{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprMonitorRemovedV2Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprMonitorRemovedV2Value) ProtoMessage() {}
+
+func (x *HyprMonitorRemovedV2Value) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprMonitorRemovedV2Value.ProtoReflect.Descriptor instead.
+func (*HyprMonitorRemovedV2Value) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HyprMonitorRemovedV2Value) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HyprMonitorRemovedV2Value) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HyprMonitorRemovedV2Value) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 type StatusNotifierValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1018,7 +2041,7 @@ type StatusNotifierValue struct {
 func (x *StatusNotifierValue) Reset() {
 	*x = StatusNotifierValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[10]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1031,7 +2054,7 @@ func (x *StatusNotifierValue) String() string {
 func (*StatusNotifierValue) ProtoMessage() {}
 
 func (x *StatusNotifierValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[10]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,7 +2067,7 @@ func (x *StatusNotifierValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusNotifierValue.ProtoReflect.Descriptor instead.
 func (*StatusNotifierValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{10}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *StatusNotifierValue) GetBusName() string {
@@ -1122,7 +2145,7 @@ type UpdateTitleValue struct {
 func (x *UpdateTitleValue) Reset() {
 	*x = UpdateTitleValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[11]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1135,7 +2158,7 @@ func (x *UpdateTitleValue) String() string {
 func (*UpdateTitleValue) ProtoMessage() {}
 
 func (x *UpdateTitleValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[11]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1148,7 +2171,7 @@ func (x *UpdateTitleValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTitleValue.ProtoReflect.Descriptor instead.
 func (*UpdateTitleValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{11}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateTitleValue) GetBusName() string {
@@ -1177,7 +2200,7 @@ type UpdateTooltipValue struct {
 func (x *UpdateTooltipValue) Reset() {
 	*x = UpdateTooltipValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[12]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1190,7 +2213,7 @@ func (x *UpdateTooltipValue) String() string {
 func (*UpdateTooltipValue) ProtoMessage() {}
 
 func (x *UpdateTooltipValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[12]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,7 +2226,7 @@ func (x *UpdateTooltipValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTooltipValue.ProtoReflect.Descriptor instead.
 func (*UpdateTooltipValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{12}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UpdateTooltipValue) GetBusName() string {
@@ -1232,7 +2255,7 @@ type UpdateIconValue struct {
 func (x *UpdateIconValue) Reset() {
 	*x = UpdateIconValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[13]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1245,7 +2268,7 @@ func (x *UpdateIconValue) String() string {
 func (*UpdateIconValue) ProtoMessage() {}
 
 func (x *UpdateIconValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[13]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1258,7 +2281,7 @@ func (x *UpdateIconValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIconValue.ProtoReflect.Descriptor instead.
 func (*UpdateIconValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{13}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *UpdateIconValue) GetBusName() string {
@@ -1287,7 +2310,7 @@ type UpdateStatusValue struct {
 func (x *UpdateStatusValue) Reset() {
 	*x = UpdateStatusValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[14]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1300,7 +2323,7 @@ func (x *UpdateStatusValue) String() string {
 func (*UpdateStatusValue) ProtoMessage() {}
 
 func (x *UpdateStatusValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[14]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1313,7 +2336,7 @@ func (x *UpdateStatusValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStatusValue.ProtoReflect.Descriptor instead.
 func (*UpdateStatusValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{14}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *UpdateStatusValue) GetBusName() string {
@@ -1342,7 +2365,7 @@ type UpdateMenuValue struct {
 func (x *UpdateMenuValue) Reset() {
 	*x = UpdateMenuValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[15]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1355,7 +2378,7 @@ func (x *UpdateMenuValue) String() string {
 func (*UpdateMenuValue) ProtoMessage() {}
 
 func (x *UpdateMenuValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[15]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1368,7 +2391,7 @@ func (x *UpdateMenuValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMenuValue.ProtoReflect.Descriptor instead.
 func (*UpdateMenuValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{15}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *UpdateMenuValue) GetBusName() string {
@@ -1404,7 +2427,7 @@ type NotificationValue struct {
 func (x *NotificationValue) Reset() {
 	*x = NotificationValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[16]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1417,7 +2440,7 @@ func (x *NotificationValue) String() string {
 func (*NotificationValue) ProtoMessage() {}
 
 func (x *NotificationValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[16]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1430,7 +2453,7 @@ func (x *NotificationValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationValue.ProtoReflect.Descriptor instead.
 func (*NotificationValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{16}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *NotificationValue) GetId() uint32 {
@@ -1513,7 +2536,7 @@ type HudNotificationValue struct {
 func (x *HudNotificationValue) Reset() {
 	*x = HudNotificationValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[17]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1526,7 +2549,7 @@ func (x *HudNotificationValue) String() string {
 func (*HudNotificationValue) ProtoMessage() {}
 
 func (x *HudNotificationValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[17]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1539,7 +2562,7 @@ func (x *HudNotificationValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HudNotificationValue.ProtoReflect.Descriptor instead.
 func (*HudNotificationValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{17}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *HudNotificationValue) GetId() string {
@@ -1608,7 +2631,7 @@ type AudioSinkChangeValue struct {
 func (x *AudioSinkChangeValue) Reset() {
 	*x = AudioSinkChangeValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[18]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1621,7 +2644,7 @@ func (x *AudioSinkChangeValue) String() string {
 func (*AudioSinkChangeValue) ProtoMessage() {}
 
 func (x *AudioSinkChangeValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[18]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1634,7 +2657,7 @@ func (x *AudioSinkChangeValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AudioSinkChangeValue.ProtoReflect.Descriptor instead.
 func (*AudioSinkChangeValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{18}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *AudioSinkChangeValue) GetId() string {
@@ -1703,7 +2726,7 @@ type AudioSourceChangeValue struct {
 func (x *AudioSourceChangeValue) Reset() {
 	*x = AudioSourceChangeValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[19]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1716,7 +2739,7 @@ func (x *AudioSourceChangeValue) String() string {
 func (*AudioSourceChangeValue) ProtoMessage() {}
 
 func (x *AudioSourceChangeValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[19]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1729,7 +2752,7 @@ func (x *AudioSourceChangeValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AudioSourceChangeValue.ProtoReflect.Descriptor instead.
 func (*AudioSourceChangeValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{19}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AudioSourceChangeValue) GetId() string {
@@ -1793,7 +2816,7 @@ type AudioSinkVolumeAdjust struct {
 func (x *AudioSinkVolumeAdjust) Reset() {
 	*x = AudioSinkVolumeAdjust{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[20]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1806,7 +2829,7 @@ func (x *AudioSinkVolumeAdjust) String() string {
 func (*AudioSinkVolumeAdjust) ProtoMessage() {}
 
 func (x *AudioSinkVolumeAdjust) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[20]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1819,7 +2842,7 @@ func (x *AudioSinkVolumeAdjust) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AudioSinkVolumeAdjust.ProtoReflect.Descriptor instead.
 func (*AudioSinkVolumeAdjust) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{20}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *AudioSinkVolumeAdjust) GetId() string {
@@ -1847,7 +2870,7 @@ type AudioSinkMuteToggle struct {
 func (x *AudioSinkMuteToggle) Reset() {
 	*x = AudioSinkMuteToggle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[21]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1860,7 +2883,7 @@ func (x *AudioSinkMuteToggle) String() string {
 func (*AudioSinkMuteToggle) ProtoMessage() {}
 
 func (x *AudioSinkMuteToggle) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[21]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1873,7 +2896,7 @@ func (x *AudioSinkMuteToggle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AudioSinkMuteToggle.ProtoReflect.Descriptor instead.
 func (*AudioSinkMuteToggle) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{21}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *AudioSinkMuteToggle) GetId() string {
@@ -1895,7 +2918,7 @@ type AudioSourceVolumeAdjust struct {
 func (x *AudioSourceVolumeAdjust) Reset() {
 	*x = AudioSourceVolumeAdjust{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[22]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1908,7 +2931,7 @@ func (x *AudioSourceVolumeAdjust) String() string {
 func (*AudioSourceVolumeAdjust) ProtoMessage() {}
 
 func (x *AudioSourceVolumeAdjust) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[22]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1921,7 +2944,7 @@ func (x *AudioSourceVolumeAdjust) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AudioSourceVolumeAdjust.ProtoReflect.Descriptor instead.
 func (*AudioSourceVolumeAdjust) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{22}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *AudioSourceVolumeAdjust) GetId() string {
@@ -1949,7 +2972,7 @@ type AudioSourceMuteToggle struct {
 func (x *AudioSourceMuteToggle) Reset() {
 	*x = AudioSourceMuteToggle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[23]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1962,7 +2985,7 @@ func (x *AudioSourceMuteToggle) String() string {
 func (*AudioSourceMuteToggle) ProtoMessage() {}
 
 func (x *AudioSourceMuteToggle) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[23]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1975,7 +2998,7 @@ func (x *AudioSourceMuteToggle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AudioSourceMuteToggle.ProtoReflect.Descriptor instead.
 func (*AudioSourceMuteToggle) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{23}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AudioSourceMuteToggle) GetId() string {
@@ -1999,7 +3022,7 @@ type BrightnessChangeValue struct {
 func (x *BrightnessChangeValue) Reset() {
 	*x = BrightnessChangeValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[24]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2012,7 +3035,7 @@ func (x *BrightnessChangeValue) String() string {
 func (*BrightnessChangeValue) ProtoMessage() {}
 
 func (x *BrightnessChangeValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[24]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2025,7 +3048,7 @@ func (x *BrightnessChangeValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BrightnessChangeValue.ProtoReflect.Descriptor instead.
 func (*BrightnessChangeValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{24}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *BrightnessChangeValue) GetId() string {
@@ -2068,7 +3091,7 @@ type BrightnessAdjustValue struct {
 func (x *BrightnessAdjustValue) Reset() {
 	*x = BrightnessAdjustValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[25]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2081,7 +3104,7 @@ func (x *BrightnessAdjustValue) String() string {
 func (*BrightnessAdjustValue) ProtoMessage() {}
 
 func (x *BrightnessAdjustValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[25]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2094,7 +3117,7 @@ func (x *BrightnessAdjustValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BrightnessAdjustValue.ProtoReflect.Descriptor instead.
 func (*BrightnessAdjustValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{25}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *BrightnessAdjustValue) GetDevName() string {
@@ -2135,7 +3158,7 @@ type PowerChangeValue struct {
 func (x *PowerChangeValue) Reset() {
 	*x = PowerChangeValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[26]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2148,7 +3171,7 @@ func (x *PowerChangeValue) String() string {
 func (*PowerChangeValue) ProtoMessage() {}
 
 func (x *PowerChangeValue) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[26]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2161,7 +3184,7 @@ func (x *PowerChangeValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowerChangeValue.ProtoReflect.Descriptor instead.
 func (*PowerChangeValue) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{26}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *PowerChangeValue) GetId() string {
@@ -2274,7 +3297,7 @@ type Event struct {
 func (x *Event) Reset() {
 	*x = Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[27]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2287,7 +3310,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[27]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2300,7 +3323,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{27}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *Event) GetKind() EventKind {
@@ -2317,6 +3340,116 @@ func (x *Event) GetData() *anypb.Any {
 	return nil
 }
 
+type HyprSnapshotValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // JSON encoded Hyprland state, as returned by the IPC queries.
+}
+
+func (x *HyprSnapshotValue) Reset() {
+	*x = HyprSnapshotValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprSnapshotValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprSnapshotValue) ProtoMessage() {}
+
+func (x *HyprSnapshotValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprSnapshotValue.ProtoReflect.Descriptor instead.
+func (*HyprSnapshotValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *HyprSnapshotValue) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type RecordedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`                                     // time that the event was broadcast by the host.
+	Event        *Event                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`                                   // the recorded event.
+	HyprSnapshot *HyprSnapshotValue     `protobuf:"bytes,3,opt,name=hypr_snapshot,json=hyprSnapshot,proto3" json:"hypr_snapshot,omitempty"` // Hyprland state when recording started, set instead of event.
+}
+
+func (x *RecordedEvent) Reset() {
+	*x = RecordedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordedEvent) ProtoMessage() {}
+
+func (x *RecordedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordedEvent.ProtoReflect.Descriptor instead.
+func (*RecordedEvent) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RecordedEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *RecordedEvent) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *RecordedEvent) GetHyprSnapshot() *HyprSnapshotValue {
+	if x != nil {
+		return x.HyprSnapshot
+	}
+	return nil
+}
+
 type StatusNotifierValue_Pixmap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2330,7 +3463,7 @@ type StatusNotifierValue_Pixmap struct {
 func (x *StatusNotifierValue_Pixmap) Reset() {
 	*x = StatusNotifierValue_Pixmap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[28]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2343,7 +3476,7 @@ func (x *StatusNotifierValue_Pixmap) String() string {
 func (*StatusNotifierValue_Pixmap) ProtoMessage() {}
 
 func (x *StatusNotifierValue_Pixmap) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[28]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2356,7 +3489,7 @@ func (x *StatusNotifierValue_Pixmap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusNotifierValue_Pixmap.ProtoReflect.Descriptor instead.
 func (*StatusNotifierValue_Pixmap) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{10, 0}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *StatusNotifierValue_Pixmap) GetWidth() int32 {
@@ -2394,7 +3527,7 @@ type StatusNotifierValue_Tooltip struct {
 func (x *StatusNotifierValue_Tooltip) Reset() {
 	*x = StatusNotifierValue_Tooltip{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[29]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2407,7 +3540,7 @@ func (x *StatusNotifierValue_Tooltip) String() string {
 func (*StatusNotifierValue_Tooltip) ProtoMessage() {}
 
 func (x *StatusNotifierValue_Tooltip) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[29]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2420,7 +3553,7 @@ func (x *StatusNotifierValue_Tooltip) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusNotifierValue_Tooltip.ProtoReflect.Descriptor instead.
 func (*StatusNotifierValue_Tooltip) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{10, 1}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{29, 1}
 }
 
 func (x *StatusNotifierValue_Tooltip) GetIconName() string {
@@ -2464,7 +3597,7 @@ type StatusNotifierValue_Icon struct {
 func (x *StatusNotifierValue_Icon) Reset() {
 	*x = StatusNotifierValue_Icon{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[30]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2477,7 +3610,7 @@ func (x *StatusNotifierValue_Icon) String() string {
 func (*StatusNotifierValue_Icon) ProtoMessage() {}
 
 func (x *StatusNotifierValue_Icon) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[30]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2490,7 +3623,7 @@ func (x *StatusNotifierValue_Icon) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusNotifierValue_Icon.ProtoReflect.Descriptor instead.
 func (*StatusNotifierValue_Icon) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{10, 2}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{29, 2}
 }
 
 func (x *StatusNotifierValue_Icon) GetIconName() string {
@@ -2527,7 +3660,7 @@ type StatusNotifierValue_Menu struct {
 func (x *StatusNotifierValue_Menu) Reset() {
 	*x = StatusNotifierValue_Menu{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[31]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2540,7 +3673,7 @@ func (x *StatusNotifierValue_Menu) String() string {
 func (*StatusNotifierValue_Menu) ProtoMessage() {}
 
 func (x *StatusNotifierValue_Menu) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[31]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2553,7 +3686,7 @@ func (x *StatusNotifierValue_Menu) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusNotifierValue_Menu.ProtoReflect.Descriptor instead.
 func (*StatusNotifierValue_Menu) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{10, 3}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{29, 3}
 }
 
 func (x *StatusNotifierValue_Menu) GetId() int32 {
@@ -2597,7 +3730,7 @@ type StatusNotifierValue_Menu_Properties struct {
 func (x *StatusNotifierValue_Menu_Properties) Reset() {
 	*x = StatusNotifierValue_Menu_Properties{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[32]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2610,7 +3743,7 @@ func (x *StatusNotifierValue_Menu_Properties) String() string {
 func (*StatusNotifierValue_Menu_Properties) ProtoMessage() {}
 
 func (x *StatusNotifierValue_Menu_Properties) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[32]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2623,7 +3756,7 @@ func (x *StatusNotifierValue_Menu_Properties) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use StatusNotifierValue_Menu_Properties.ProtoReflect.Descriptor instead.
 func (*StatusNotifierValue_Menu_Properties) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{10, 3, 0}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{29, 3, 0}
 }
 
 func (x *StatusNotifierValue_Menu_Properties) GetLabel() string {
@@ -2708,7 +3841,7 @@ type NotificationValue_Hint struct {
 func (x *NotificationValue_Hint) Reset() {
 	*x = NotificationValue_Hint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[33]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2721,7 +3854,7 @@ func (x *NotificationValue_Hint) String() string {
 func (*NotificationValue_Hint) ProtoMessage() {}
 
 func (x *NotificationValue_Hint) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[33]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2734,7 +3867,7 @@ func (x *NotificationValue_Hint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationValue_Hint.ProtoReflect.Descriptor instead.
 func (*NotificationValue_Hint) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{16, 0}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{35, 0}
 }
 
 func (x *NotificationValue_Hint) GetKey() string {
@@ -2763,7 +3896,7 @@ type NotificationValue_Action struct {
 func (x *NotificationValue_Action) Reset() {
 	*x = NotificationValue_Action{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[34]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2776,7 +3909,7 @@ func (x *NotificationValue_Action) String() string {
 func (*NotificationValue_Action) ProtoMessage() {}
 
 func (x *NotificationValue_Action) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[34]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2789,7 +3922,7 @@ func (x *NotificationValue_Action) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationValue_Action.ProtoReflect.Descriptor instead.
 func (*NotificationValue_Action) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{16, 1}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{35, 1}
 }
 
 func (x *NotificationValue_Action) GetKey() string {
@@ -2823,7 +3956,7 @@ type NotificationValue_Pixmap struct {
 func (x *NotificationValue_Pixmap) Reset() {
 	*x = NotificationValue_Pixmap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[35]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2836,7 +3969,7 @@ func (x *NotificationValue_Pixmap) String() string {
 func (*NotificationValue_Pixmap) ProtoMessage() {}
 
 func (x *NotificationValue_Pixmap) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[35]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2849,7 +3982,7 @@ func (x *NotificationValue_Pixmap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationValue_Pixmap.ProtoReflect.Descriptor instead.
 func (*NotificationValue_Pixmap) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{16, 2}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{35, 2}
 }
 
 func (x *NotificationValue_Pixmap) GetWidth() int32 {
@@ -2911,328 +4044,422 @@ var file_hyprpanel_event_v1_event_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x20, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x3a, 0x0a, 0x14, 0x48, 0x79, 0x70, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x56, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x41, 0x0a,
-	0x1b, 0x48, 0x79, 0x70, 0x72, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x57, 0x6f, 0x72, 0x6b,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x20, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x3a, 0x0a, 0x14, 0x48, 0x79, 0x70, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x56, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x41,
+	0x0a, 0x1b, 0x48, 0x79, 0x70, 0x72, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x40, 0x0a, 0x1a, 0x48, 0x79, 0x70, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x56, 0x0a, 0x13, 0x48, 0x79, 0x70, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x7b, 0x0a, 0x15, 0x48,
+	0x79, 0x70, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x56, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x16, 0x48, 0x79, 0x70, 0x72,
+	0x4d, 0x6f, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x22, 0x58, 0x0a, 0x18, 0x48, 0x79, 0x70, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b,
 	0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x40, 0x0a, 0x1a, 0x48, 0x79, 0x70, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x56, 0x0a, 0x13, 0x48, 0x79, 0x70, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x7b, 0x0a, 0x15, 0x48, 0x79,
-	0x70, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x56, 0x32, 0x56, 0x61,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x22, 0x3e, 0x0a, 0x18, 0x48, 0x79,
+	0x70, 0x72, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x15, 0x48, 0x79,
+	0x70, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22,
+	0x82, 0x01, 0x0a, 0x13, 0x48, 0x79, 0x70, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x22, 0x33, 0x0a, 0x17, 0x48, 0x79, 0x70, 0x72, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x56, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x35, 0x0a, 0x13, 0x48, 0x79, 0x70,
+	0x72, 0x46, 0x75, 0x6c, 0x6c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x22, 0x2b, 0x0a, 0x0f, 0x48, 0x79, 0x70, 0x72, 0x55, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x56, 0x61,
 	0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a,
-	0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x16, 0x48, 0x79, 0x70, 0x72, 0x4d,
-	0x6f, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x22,
-	0x58, 0x0a, 0x18, 0x48, 0x79, 0x70, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x56, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x22, 0x3e, 0x0a, 0x18, 0x48, 0x79, 0x70,
-	0x72, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4b, 0x0a,
+	0x11, 0x48, 0x79, 0x70, 0x72, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x50, 0x0a, 0x13, 0x48, 0x79,
+	0x70, 0x72, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x63, 0x61, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x68, 0x61, 0x72, 0x65, 0x22, 0x53, 0x0a, 0x1b,
+	0x48, 0x79, 0x70, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x22, 0x30, 0x0a, 0x14, 0x48, 0x79, 0x70, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x32, 0x0a, 0x12, 0x48, 0x79, 0x70, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x33, 0x0a, 0x13, 0x48, 0x79, 0x70, 0x72, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x25, 0x0a, 0x0f,
+	0x48, 0x79, 0x70, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x16, 0x48, 0x79, 0x70, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x56, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x58, 0x0a,
+	0x18, 0x48, 0x79, 0x70, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x61, 0x6c, 0x56, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x0c, 0x48, 0x79, 0x70, 0x72, 0x50,
+	0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x0d, 0x48, 0x79, 0x70,
+	0x72, 0x42, 0x65, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x48, 0x0a, 0x14, 0x48, 0x79, 0x70, 0x72, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6f, 0x70, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x32,
+	0x0a, 0x16, 0x48, 0x79, 0x70, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x74, 0x6f, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x33, 0x0a, 0x17, 0x48, 0x79, 0x70, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x75,
+	0x74, 0x4f, 0x66, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5f, 0x0a, 0x17, 0x48, 0x79, 0x70, 0x72, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x65, 0x64, 0x56, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x19, 0x48, 0x79, 0x70, 0x72,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x56, 0x32,
 	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x15, 0x48, 0x79, 0x70,
-	0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x82,
-	0x01, 0x0a, 0x13, 0x48, 0x79, 0x70, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x22, 0xaf, 0x0a, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62,
-	0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
-	0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3b, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
-	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x49, 0x0a, 0x07, 0x74, 0x6f,
-	0x6f, 0x6c, 0x74, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x68, 0x79,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaf, 0x0a, 0x0a, 0x13,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74,
+	0x72, 0x61, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x49, 0x0a, 0x07, 0x74, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x54, 0x6f, 0x6f,
+	0x6c, 0x74, 0x69, 0x70, 0x52, 0x07, 0x74, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x12, 0x40, 0x0a,
+	0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x79,
 	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
 	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x52, 0x07, 0x74, 0x6f,
-	0x6f, 0x6c, 0x74, 0x69, 0x70, 0x12, 0x40, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x49, 0x63, 0x6f,
-	0x6e, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x4d,
-	0x65, 0x6e, 0x75, 0x52, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6e,
-	0x75, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x4a,
+	0x61, 0x6c, 0x75, 0x65, 0x2e, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12,
+	0x40, 0x0a, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x04, 0x6d, 0x65, 0x6e,
+	0x75, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x4a, 0x0a, 0x06, 0x50, 0x69, 0x78, 0x6d, 0x61, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x1a, 0xa1, 0x01, 0x0a, 0x07, 0x54, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4f, 0x0a, 0x0b, 0x69,
+	0x63, 0x6f, 0x6e, 0x5f, 0x70, 0x69, 0x78, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x50, 0x69, 0x78, 0x6d, 0x61, 0x70,
+	0x52, 0x0a, 0x69, 0x63, 0x6f, 0x6e, 0x50, 0x69, 0x78, 0x6d, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x9c, 0x01, 0x0a, 0x04, 0x49, 0x63, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x63, 0x6f, 0x6e, 0x54, 0x68, 0x65, 0x6d, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x4f, 0x0a, 0x0b, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x70, 0x69, 0x78,
+	0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x2e, 0x50, 0x69, 0x78, 0x6d, 0x61, 0x70, 0x52, 0x0a, 0x69, 0x63, 0x6f, 0x6e, 0x50,
+	0x69, 0x78, 0x6d, 0x61, 0x70, 0x1a, 0xf5, 0x03, 0x0a, 0x04, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x57,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x1a, 0xb9, 0x02, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x62, 0x6f, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x62, 0x6f, 0x78, 0x22, 0x43, 0x0a,
+	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x22, 0x7a, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6f, 0x6c,
+	0x74, 0x69, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x74, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x54, 0x6f,
+	0x6f, 0x6c, 0x74, 0x69, 0x70, 0x52, 0x07, 0x74, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x22, 0x6e,
+	0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x63, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x04,
+	0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x2e, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0x6b,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6e, 0x0a, 0x0f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x6d, 0x65, 0x6e,
+	0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x22, 0xac, 0x05, 0x0a, 0x11,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x70, 0x70, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x46, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40,
+	0x0a, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x2e, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x44, 0x0a, 0x04, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x30, 0x0a, 0x06, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xca, 0x01,
 	0x0a, 0x06, 0x50, 0x69, 0x78, 0x6d, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74,
 	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16,
 	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xa1, 0x01, 0x0a, 0x07, 0x54,
-	0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x4f, 0x0a, 0x0b, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x70, 0x69, 0x78, 0x6d,
-	0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
-	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x2e, 0x50, 0x69, 0x78, 0x6d, 0x61, 0x70, 0x52, 0x0a, 0x69, 0x63, 0x6f, 0x6e, 0x50, 0x69,
-	0x78, 0x6d, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f,
-	0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x9c,
-	0x01, 0x0a, 0x04, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x63, 0x6f, 0x6e, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x65,
-	0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69,
-	0x63, 0x6f, 0x6e, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4f, 0x0a, 0x0b,
-	0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x70, 0x69, 0x78, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x50, 0x69, 0x78, 0x6d, 0x61,
-	0x70, 0x52, 0x0a, 0x69, 0x63, 0x6f, 0x6e, 0x50, 0x69, 0x78, 0x6d, 0x61, 0x70, 0x1a, 0xf5, 0x03,
-	0x0a, 0x04, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x57, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x68, 0x79, 0x70,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x77, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x77, 0x53,
+	0x74, 0x72, 0x69, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x73, 0x5f, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x61, 0x73, 0x41, 0x6c, 0x70,
+	0x68, 0x61, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x69, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x69, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc4, 0x01, 0x0a, 0x14, 0x48,
+	0x75, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x63, 0x6f, 0x6e, 0x5f,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x69, 0x63, 0x6f, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4d, 0x61,
+	0x78, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4d, 0x61,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6d, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22,
+	0xbd, 0x01, 0x0a, 0x16, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4d, 0x61,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6d, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22,
+	0x64, 0x0a, 0x15, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x0a, 0x13, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69,
+	0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x17,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70,
 	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12,
-	0x48, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x52,
-	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x1a, 0xb9, 0x02, 0x0a, 0x0a, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69,
-	0x63, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x69, 0x63, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x67, 0x67,
-	0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
-	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69,
-	0x73, 0x5f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69,
-	0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
-	0x73, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f,
-	0x72, 0x61, 0x64, 0x69, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x52,
-	0x61, 0x64, 0x69, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x62, 0x6f, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x62, 0x6f, 0x78, 0x22, 0x43, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x74, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x7a, 0x0a, 0x12, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x74,
-	0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x68,
-	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x52, 0x07, 0x74,
-	0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x22, 0x6e, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x49, 0x63, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x49, 0x63, 0x6f, 0x6e,
-	0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62,
-	0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
-	0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
-	0x65, 0x6c, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73,
-	0x74, 0x72, 0x61, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x6e, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e,
-	0x75, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x40, 0x0a, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x04, 0x6d,
-	0x65, 0x6e, 0x75, 0x22, 0xac, 0x05, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x73, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x63, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x49, 0x63, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f,
-	0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x46,
-	0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x48, 0x69, 0x6e,
-	0x74, 0x52, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x15, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x82, 0x01,
+	0x0a, 0x15, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62,
+	0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x4d,
+	0x61, 0x78, 0x22, 0x6f, 0x0a, 0x15, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x64,
+	0x65, 0x76, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64,
+	0x65, 0x76, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x80, 0x04, 0x0a, 0x10, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x66,
+	0x75, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x44, 0x0a,
-	0x04, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x30, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xca, 0x01, 0x0a, 0x06, 0x50, 0x69, 0x78, 0x6d, 0x61, 0x70,
-	0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x6f, 0x77, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x77, 0x53, 0x74, 0x72, 0x69, 0x64, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x68, 0x61, 0x73, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x68, 0x61, 0x73, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x69,
-	0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x69, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0xc4, 0x01, 0x0a, 0x14, 0x48, 0x75, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69,
-	0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12,
-	0x23, 0x0a, 0x0d, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x63, 0x6f, 0x6e, 0x53, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f,
-	0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x41, 0x75,
-	0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x75, 0x74,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x16, 0x41, 0x75, 0x64, 0x69,
-	0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x75, 0x74,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x64, 0x0a, 0x15, 0x41, 0x75, 0x64, 0x69, 0x6f,
-	0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x0a,
-	0x13, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x17, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x15,
-	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54,
-	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74,
-	0x6e, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e,
-	0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73,
-	0x73, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x72, 0x69,
-	0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x78, 0x22, 0x6f, 0x0a, 0x15, 0x42, 0x72,
-	0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x76, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b,
-	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x04, 0x0a, 0x10,
-	0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x31,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68,
-	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x75,
-	0x70, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x3d, 0x0a, 0x0d,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
-	0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0c, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x74, 0x69,
-	0x6d, 0x65, 0x54, 0x6f, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63,
-	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x06, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e,
-	0x65, 0x72, 0x67, 0x79, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0b, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x1f, 0x0a,
-	0x0b, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0a, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x46, 0x75, 0x6c, 0x6c, 0x22, 0x64,
-	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x2a, 0x4c, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x46, 0x75, 0x6c, 0x6c,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x34, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e,
+	0x65, 0x72, 0x67, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x65, 0x6e, 0x65, 0x72,
+	0x67, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f,
+	0x66, 0x75, 0x6c, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x6e, 0x65, 0x72,
+	0x67, 0x79, 0x46, 0x75, 0x6c, 0x6c, 0x22, 0x64, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x31, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x27, 0x0a, 0x11,
+	0x48, 0x79, 0x70, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbc, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x0d, 0x68, 0x79, 0x70, 0x72,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x79, 0x70, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x68, 0x79, 0x70, 0x72, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x2a, 0x4c, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
 	0x6e, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
 	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
 	0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x12,
@@ -3265,7 +4492,7 @@ var file_hyprpanel_event_v1_event_proto_rawDesc = []byte{
 	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x10, 0x05, 0x12, 0x21, 0x0a,
 	0x1d, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e,
 	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x10, 0x06,
-	0x2a, 0x86, 0x10, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a,
+	0x2a, 0xf8, 0x12, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a,
 	0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53,
 	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56,
 	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x57, 0x4f,
@@ -3393,20 +4620,43 @@ var file_hyprpanel_event_v1_event_proto_rawDesc = []byte{
 	0x32, 0x10, 0x39, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
 	0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45,
 	0x56, 0x32, 0x10, 0x3a, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
-	0x4e, 0x44, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x10, 0x3b, 0x42, 0xc9, 0x01, 0x0a, 0x16, 0x63, 0x6f,
-	0x6d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
-	0x64, 0x66, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x48, 0x45, 0x58, 0xaa, 0x02, 0x12, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x48, 0x79, 0x70, 0x72, 0x70,
-	0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e,
-	0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x14, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4e, 0x44, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x10, 0x3b, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x52, 0x45, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x3c, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x57, 0x49,
+	0x4e, 0x44, 0x4f, 0x57, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x56, 0x32, 0x10, 0x3d, 0x12, 0x23, 0x0a,
+	0x1f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x53, 0x50, 0x45, 0x43, 0x49, 0x41, 0x4c, 0x56, 0x32,
+	0x10, 0x3e, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x50, 0x49, 0x4e, 0x10, 0x3f, 0x12, 0x22, 0x0a, 0x1e, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x52, 0x45, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x44, 0x10, 0x40, 0x12,
+	0x18, 0x0a, 0x14, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59,
+	0x50, 0x52, 0x5f, 0x42, 0x45, 0x4c, 0x4c, 0x10, 0x41, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x54, 0x4f, 0x47,
+	0x47, 0x4c, 0x45, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x42, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4d, 0x4f,
+	0x56, 0x45, 0x49, 0x4e, 0x54, 0x4f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x43, 0x12, 0x22, 0x0a,
+	0x1e, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52,
+	0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4f, 0x55, 0x54, 0x4f, 0x46, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10,
+	0x44, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x48, 0x59, 0x50, 0x52, 0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x41, 0x44, 0x44, 0x45,
+	0x44, 0x56, 0x32, 0x10, 0x45, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52,
+	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x56, 0x32, 0x10, 0x46, 0x42, 0xc9, 0x01, 0x0a, 0x16,
+	0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x64, 0x66, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x48, 0x45, 0x58, 0xaa, 0x02, 0x12, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x48, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1e, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x14, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3422,7 +4672,7 @@ func file_hyprpanel_event_v1_event_proto_rawDescGZIP() []byte {
 }
 
 var file_hyprpanel_event_v1_event_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_hyprpanel_event_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_hyprpanel_event_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_hyprpanel_event_v1_event_proto_goTypes = []interface{}{
 	(Direction)(0),                              // 0: hyprpanel.event.v1.Direction
 	(PowerType)(0),                              // 1: hyprpanel.event.v1.PowerType
@@ -3438,67 +4688,92 @@ var file_hyprpanel_event_v1_event_proto_goTypes = []interface{}{
 	(*HyprRenameWorkspaceValue)(nil),            // 11: hyprpanel.event.v1.HyprRenameWorkspaceValue
 	(*HyprActiveWindowValue)(nil),               // 12: hyprpanel.event.v1.HyprActiveWindowValue
 	(*HyprOpenWindowValue)(nil),                 // 13: hyprpanel.event.v1.HyprOpenWindowValue
-	(*StatusNotifierValue)(nil),                 // 14: hyprpanel.event.v1.StatusNotifierValue
-	(*UpdateTitleValue)(nil),                    // 15: hyprpanel.event.v1.UpdateTitleValue
-	(*UpdateTooltipValue)(nil),                  // 16: hyprpanel.event.v1.UpdateTooltipValue
-	(*UpdateIconValue)(nil),                     // 17: hyprpanel.event.v1.UpdateIconValue
-	(*UpdateStatusValue)(nil),                   // 18: hyprpanel.event.v1.UpdateStatusValue
-	(*UpdateMenuValue)(nil),                     // 19: hyprpanel.event.v1.UpdateMenuValue
-	(*NotificationValue)(nil),                   // 20: hyprpanel.event.v1.NotificationValue
-	(*HudNotificationValue)(nil),                // 21: hyprpanel.event.v1.HudNotificationValue
-	(*AudioSinkChangeValue)(nil),                // 22: hyprpanel.event.v1.AudioSinkChangeValue
-	(*AudioSourceChangeValue)(nil),              // 23: hyprpanel.event.v1.AudioSourceChangeValue
-	(*AudioSinkVolumeAdjust)(nil),               // 24: hyprpanel.event.v1.AudioSinkVolumeAdjust
-	(*AudioSinkMuteToggle)(nil),                 // 25: hyprpanel.event.v1.AudioSinkMuteToggle
-	(*AudioSourceVolumeAdjust)(nil),             // 26: hyprpanel.event.v1.AudioSourceVolumeAdjust
-	(*AudioSourceMuteToggle)(nil),               // 27: hyprpanel.event.v1.AudioSourceMuteToggle
-	(*BrightnessChangeValue)(nil),               // 28: hyprpanel.event.v1.BrightnessChangeValue
-	(*BrightnessAdjustValue)(nil),               // 29: hyprpanel.event.v1.BrightnessAdjustValue
-	(*PowerChangeValue)(nil),                    // 30: hyprpanel.event.v1.PowerChangeValue
-	(*Event)(nil),                               // 31: hyprpanel.event.v1.Event
-	(*StatusNotifierValue_Pixmap)(nil),          // 32: hyprpanel.event.v1.StatusNotifierValue.Pixmap
-	(*StatusNotifierValue_Tooltip)(nil),         // 33: hyprpanel.event.v1.StatusNotifierValue.Tooltip
-	(*StatusNotifierValue_Icon)(nil),            // 34: hyprpanel.event.v1.StatusNotifierValue.Icon
-	(*StatusNotifierValue_Menu)(nil),            // 35: hyprpanel.event.v1.StatusNotifierValue.Menu
-	(*StatusNotifierValue_Menu_Properties)(nil), // 36: hyprpanel.event.v1.StatusNotifierValue.Menu.Properties
-	(*NotificationValue_Hint)(nil),              // 37: hyprpanel.event.v1.NotificationValue.Hint
-	(*NotificationValue_Action)(nil),            // 38: hyprpanel.event.v1.NotificationValue.Action
-	(*NotificationValue_Pixmap)(nil),            // 39: hyprpanel.event.v1.NotificationValue.Pixmap
-	(v1.Systray_Status)(0),                      // 40: hyprpanel.module.v1.Systray.Status
-	(*durationpb.Duration)(nil),                 // 41: google.protobuf.Duration
-	(*anypb.Any)(nil),                           // 42: google.protobuf.Any
+	(*HyprActiveWindowV2Value)(nil),             // 14: hyprpanel.event.v1.HyprActiveWindowV2Value
+	(*HyprFullscreenValue)(nil),                 // 15: hyprpanel.event.v1.HyprFullscreenValue
+	(*HyprUrgentValue)(nil),                     // 16: hyprpanel.event.v1.HyprUrgentValue
+	(*HyprMinimizeValue)(nil),                   // 17: hyprpanel.event.v1.HyprMinimizeValue
+	(*HyprScreencastValue)(nil),                 // 18: hyprpanel.event.v1.HyprScreencastValue
+	(*HyprChangeFloatingModeValue)(nil),         // 19: hyprpanel.event.v1.HyprChangeFloatingModeValue
+	(*HyprWindowTitleValue)(nil),                // 20: hyprpanel.event.v1.HyprWindowTitleValue
+	(*HyprOpenLayerValue)(nil),                  // 21: hyprpanel.event.v1.HyprOpenLayerValue
+	(*HyprCloseLayerValue)(nil),                 // 22: hyprpanel.event.v1.HyprCloseLayerValue
+	(*HyprSubmapValue)(nil),                     // 23: hyprpanel.event.v1.HyprSubmapValue
+	(*HyprWindowTitleV2Value)(nil),              // 24: hyprpanel.event.v1.HyprWindowTitleV2Value
+	(*HyprActiveSpecialV2Value)(nil),            // 25: hyprpanel.event.v1.HyprActiveSpecialV2Value
+	(*HyprPinValue)(nil),                        // 26: hyprpanel.event.v1.HyprPinValue
+	(*HyprBellValue)(nil),                       // 27: hyprpanel.event.v1.HyprBellValue
+	(*HyprToggleGroupValue)(nil),                // 28: hyprpanel.event.v1.HyprToggleGroupValue
+	(*HyprMoveIntoGroupValue)(nil),              // 29: hyprpanel.event.v1.HyprMoveIntoGroupValue
+	(*HyprMoveOutOfGroupValue)(nil),             // 30: hyprpanel.event.v1.HyprMoveOutOfGroupValue
+	(*HyprMonitorAddedV2Value)(nil),             // 31: hyprpanel.event.v1.HyprMonitorAddedV2Value
+	(*HyprMonitorRemovedV2Value)(nil),           // 32: hyprpanel.event.v1.HyprMonitorRemovedV2Value
+	(*StatusNotifierValue)(nil),                 // 33: hyprpanel.event.v1.StatusNotifierValue
+	(*UpdateTitleValue)(nil),                    // 34: hyprpanel.event.v1.UpdateTitleValue
+	(*UpdateTooltipValue)(nil),                  // 35: hyprpanel.event.v1.UpdateTooltipValue
+	(*UpdateIconValue)(nil),                     // 36: hyprpanel.event.v1.UpdateIconValue
+	(*UpdateStatusValue)(nil),                   // 37: hyprpanel.event.v1.UpdateStatusValue
+	(*UpdateMenuValue)(nil),                     // 38: hyprpanel.event.v1.UpdateMenuValue
+	(*NotificationValue)(nil),                   // 39: hyprpanel.event.v1.NotificationValue
+	(*HudNotificationValue)(nil),                // 40: hyprpanel.event.v1.HudNotificationValue
+	(*AudioSinkChangeValue)(nil),                // 41: hyprpanel.event.v1.AudioSinkChangeValue
+	(*AudioSourceChangeValue)(nil),              // 42: hyprpanel.event.v1.AudioSourceChangeValue
+	(*AudioSinkVolumeAdjust)(nil),               // 43: hyprpanel.event.v1.AudioSinkVolumeAdjust
+	(*AudioSinkMuteToggle)(nil),                 // 44: hyprpanel.event.v1.AudioSinkMuteToggle
+	(*AudioSourceVolumeAdjust)(nil),             // 45: hyprpanel.event.v1.AudioSourceVolumeAdjust
+	(*AudioSourceMuteToggle)(nil),               // 46: hyprpanel.event.v1.AudioSourceMuteToggle
+	(*BrightnessChangeValue)(nil),               // 47: hyprpanel.event.v1.BrightnessChangeValue
+	(*BrightnessAdjustValue)(nil),               // 48: hyprpanel.event.v1.BrightnessAdjustValue
+	(*PowerChangeValue)(nil),                    // 49: hyprpanel.event.v1.PowerChangeValue
+	(*Event)(nil),                               // 50: hyprpanel.event.v1.Event
+	(*HyprSnapshotValue)(nil),                   // 51: hyprpanel.event.v1.HyprSnapshotValue
+	(*RecordedEvent)(nil),                       // 52: hyprpanel.event.v1.RecordedEvent
+	(*StatusNotifierValue_Pixmap)(nil),          // 53: hyprpanel.event.v1.StatusNotifierValue.Pixmap
+	(*StatusNotifierValue_Tooltip)(nil),         // 54: hyprpanel.event.v1.StatusNotifierValue.Tooltip
+	(*StatusNotifierValue_Icon)(nil),            // 55: hyprpanel.event.v1.StatusNotifierValue.Icon
+	(*StatusNotifierValue_Menu)(nil),            // 56: hyprpanel.event.v1.StatusNotifierValue.Menu
+	(*StatusNotifierValue_Menu_Properties)(nil), // 57: hyprpanel.event.v1.StatusNotifierValue.Menu.Properties
+	(*NotificationValue_Hint)(nil),              // 58: hyprpanel.event.v1.NotificationValue.Hint
+	(*NotificationValue_Action)(nil),            // 59: hyprpanel.event.v1.NotificationValue.Action
+	(*NotificationValue_Pixmap)(nil),            // 60: hyprpanel.event.v1.NotificationValue.Pixmap
+	(v1.Systray_Status)(0),                      // 61: hyprpanel.module.v1.Systray.Status
+	(*durationpb.Duration)(nil),                 // 62: google.protobuf.Duration
+	(*anypb.Any)(nil),                           // 63: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),               // 64: google.protobuf.Timestamp
 }
 var file_hyprpanel_event_v1_event_proto_depIdxs = []int32{
-	40, // 0: hyprpanel.event.v1.StatusNotifierValue.status:type_name -> hyprpanel.module.v1.Systray.Status
-	33, // 1: hyprpanel.event.v1.StatusNotifierValue.tooltip:type_name -> hyprpanel.event.v1.StatusNotifierValue.Tooltip
-	34, // 2: hyprpanel.event.v1.StatusNotifierValue.icon:type_name -> hyprpanel.event.v1.StatusNotifierValue.Icon
-	35, // 3: hyprpanel.event.v1.StatusNotifierValue.menu:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu
-	33, // 4: hyprpanel.event.v1.UpdateTooltipValue.tooltip:type_name -> hyprpanel.event.v1.StatusNotifierValue.Tooltip
-	34, // 5: hyprpanel.event.v1.UpdateIconValue.icon:type_name -> hyprpanel.event.v1.StatusNotifierValue.Icon
-	40, // 6: hyprpanel.event.v1.UpdateStatusValue.status:type_name -> hyprpanel.module.v1.Systray.Status
-	35, // 7: hyprpanel.event.v1.UpdateMenuValue.menu:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu
-	38, // 8: hyprpanel.event.v1.NotificationValue.actions:type_name -> hyprpanel.event.v1.NotificationValue.Action
-	37, // 9: hyprpanel.event.v1.NotificationValue.hints:type_name -> hyprpanel.event.v1.NotificationValue.Hint
-	41, // 10: hyprpanel.event.v1.NotificationValue.timeout:type_name -> google.protobuf.Duration
+	61, // 0: hyprpanel.event.v1.StatusNotifierValue.status:type_name -> hyprpanel.module.v1.Systray.Status
+	54, // 1: hyprpanel.event.v1.StatusNotifierValue.tooltip:type_name -> hyprpanel.event.v1.StatusNotifierValue.Tooltip
+	55, // 2: hyprpanel.event.v1.StatusNotifierValue.icon:type_name -> hyprpanel.event.v1.StatusNotifierValue.Icon
+	56, // 3: hyprpanel.event.v1.StatusNotifierValue.menu:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu
+	54, // 4: hyprpanel.event.v1.UpdateTooltipValue.tooltip:type_name -> hyprpanel.event.v1.StatusNotifierValue.Tooltip
+	55, // 5: hyprpanel.event.v1.UpdateIconValue.icon:type_name -> hyprpanel.event.v1.StatusNotifierValue.Icon
+	61, // 6: hyprpanel.event.v1.UpdateStatusValue.status:type_name -> hyprpanel.module.v1.Systray.Status
+	56, // 7: hyprpanel.event.v1.UpdateMenuValue.menu:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu
+	59, // 8: hyprpanel.event.v1.NotificationValue.actions:type_name -> hyprpanel.event.v1.NotificationValue.Action
+	58, // 9: hyprpanel.event.v1.NotificationValue.hints:type_name -> hyprpanel.event.v1.NotificationValue.Hint
+	62, // 10: hyprpanel.event.v1.NotificationValue.timeout:type_name -> google.protobuf.Duration
 	0,  // 11: hyprpanel.event.v1.AudioSinkVolumeAdjust.direction:type_name -> hyprpanel.event.v1.Direction
 	0,  // 12: hyprpanel.event.v1.AudioSourceVolumeAdjust.direction:type_name -> hyprpanel.event.v1.Direction
 	0,  // 13: hyprpanel.event.v1.BrightnessAdjustValue.direction:type_name -> hyprpanel.event.v1.Direction
 	1,  // 14: hyprpanel.event.v1.PowerChangeValue.type:type_name -> hyprpanel.event.v1.PowerType
-	41, // 15: hyprpanel.event.v1.PowerChangeValue.time_to_empty:type_name -> google.protobuf.Duration
-	41, // 16: hyprpanel.event.v1.PowerChangeValue.time_to_full:type_name -> google.protobuf.Duration
+	62, // 15: hyprpanel.event.v1.PowerChangeValue.time_to_empty:type_name -> google.protobuf.Duration
+	62, // 16: hyprpanel.event.v1.PowerChangeValue.time_to_full:type_name -> google.protobuf.Duration
 	2,  // 17: hyprpanel.event.v1.PowerChangeValue.state:type_name -> hyprpanel.event.v1.PowerState
 	3,  // 18: hyprpanel.event.v1.Event.kind:type_name -> hyprpanel.event.v1.EventKind
-	42, // 19: hyprpanel.event.v1.Event.data:type_name -> google.protobuf.Any
-	32, // 20: hyprpanel.event.v1.StatusNotifierValue.Tooltip.icon_pixmap:type_name -> hyprpanel.event.v1.StatusNotifierValue.Pixmap
-	32, // 21: hyprpanel.event.v1.StatusNotifierValue.Icon.icon_pixmap:type_name -> hyprpanel.event.v1.StatusNotifierValue.Pixmap
-	36, // 22: hyprpanel.event.v1.StatusNotifierValue.Menu.properties:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu.Properties
-	35, // 23: hyprpanel.event.v1.StatusNotifierValue.Menu.children:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu
-	42, // 24: hyprpanel.event.v1.NotificationValue.Hint.value:type_name -> google.protobuf.Any
-	25, // [25:25] is the sub-list for method output_type
-	25, // [25:25] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	63, // 19: hyprpanel.event.v1.Event.data:type_name -> google.protobuf.Any
+	64, // 20: hyprpanel.event.v1.RecordedEvent.time:type_name -> google.protobuf.Timestamp
+	50, // 21: hyprpanel.event.v1.RecordedEvent.event:type_name -> hyprpanel.event.v1.Event
+	51, // 22: hyprpanel.event.v1.RecordedEvent.hypr_snapshot:type_name -> hyprpanel.event.v1.HyprSnapshotValue
+	53, // 23: hyprpanel.event.v1.StatusNotifierValue.Tooltip.icon_pixmap:type_name -> hyprpanel.event.v1.StatusNotifierValue.Pixmap
+	53, // 24: hyprpanel.event.v1.StatusNotifierValue.Icon.icon_pixmap:type_name -> hyprpanel.event.v1.StatusNotifierValue.Pixmap
+	57, // 25: hyprpanel.event.v1.StatusNotifierValue.Menu.properties:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu.Properties
+	56, // 26: hyprpanel.event.v1.StatusNotifierValue.Menu.children:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu
+	63, // 27: hyprpanel.event.v1.NotificationValue.Hint.value:type_name -> google.protobuf.Any
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_hyprpanel_event_v1_event_proto_init() }
@@ -3519,8 +4794,56 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HyprDestroyWorkspaceV2Value); i {
+		file_hyprpanel_event_v1_event_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprDestroyWorkspaceV2Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprCreateWorkspaceV2Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprMoveWindowValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprMoveWindowV2Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprMoveWorkspaceValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3531,8 +4854,8 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HyprCreateWorkspaceV2Value); i {
+		file_hyprpanel_event_v1_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprMoveWorkspaceV2Value); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3543,8 +4866,8 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HyprMoveWindowValue); i {
+		file_hyprpanel_event_v1_event_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprRenameWorkspaceValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3555,8 +4878,8 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HyprMoveWindowV2Value); i {
+		file_hyprpanel_event_v1_event_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprActiveWindowValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3567,8 +4890,8 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HyprMoveWorkspaceValue); i {
+		file_hyprpanel_event_v1_event_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprOpenWindowValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3579,8 +4902,8 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HyprMoveWorkspaceV2Value); i {
+		file_hyprpanel_event_v1_event_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprActiveWindowV2Value); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3591,8 +4914,8 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HyprRenameWorkspaceValue); i {
+		file_hyprpanel_event_v1_event_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprFullscreenValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3603,8 +4926,8 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HyprActiveWindowValue); i {
+		file_hyprpanel_event_v1_event_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprUrgentValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3615,8 +4938,8 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HyprOpenWindowValue); i {
+		file_hyprpanel_event_v1_event_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprMinimizeValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3627,7 +4950,187 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprScreencastValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprChangeFloatingModeValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprWindowTitleValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprOpenLayerValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprCloseLayerValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprSubmapValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprWindowTitleV2Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprActiveSpecialV2Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprPinValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprBellValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprToggleGroupValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprMoveIntoGroupValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprMoveOutOfGroupValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprMonitorAddedV2Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprMonitorRemovedV2Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusNotifierValue); i {
 			case 0:
 				return &v.state
@@ -3639,7 +5142,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateTitleValue); i {
 			case 0:
 				return &v.state
@@ -3651,7 +5154,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateTooltipValue); i {
 			case 0:
 				return &v.state
@@ -3663,7 +5166,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateIconValue); i {
 			case 0:
 				return &v.state
@@ -3675,7 +5178,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateStatusValue); i {
 			case 0:
 				return &v.state
@@ -3687,7 +5190,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateMenuValue); i {
 			case 0:
 				return &v.state
@@ -3699,7 +5202,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotificationValue); i {
 			case 0:
 				return &v.state
@@ -3711,7 +5214,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HudNotificationValue); i {
 			case 0:
 				return &v.state
@@ -3723,7 +5226,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AudioSinkChangeValue); i {
 			case 0:
 				return &v.state
@@ -3735,7 +5238,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AudioSourceChangeValue); i {
 			case 0:
 				return &v.state
@@ -3747,7 +5250,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AudioSinkVolumeAdjust); i {
 			case 0:
 				return &v.state
@@ -3759,7 +5262,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AudioSinkMuteToggle); i {
 			case 0:
 				return &v.state
@@ -3771,7 +5274,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AudioSourceVolumeAdjust); i {
 			case 0:
 				return &v.state
@@ -3783,7 +5286,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AudioSourceMuteToggle); i {
 			case 0:
 				return &v.state
@@ -3795,7 +5298,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BrightnessChangeValue); i {
 			case 0:
 				return &v.state
@@ -3807,7 +5310,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BrightnessAdjustValue); i {
 			case 0:
 				return &v.state
@@ -3819,7 +5322,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PowerChangeValue); i {
 			case 0:
 				return &v.state
@@ -3831,7 +5334,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
@@ -3843,7 +5346,31 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HyprSnapshotValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusNotifierValue_Pixmap); i {
 			case 0:
 				return &v.state
@@ -3855,7 +5382,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusNotifierValue_Tooltip); i {
 			case 0:
 				return &v.state
@@ -3867,7 +5394,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusNotifierValue_Icon); i {
 			case 0:
 				return &v.state
@@ -3879,7 +5406,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusNotifierValue_Menu); i {
 			case 0:
 				return &v.state
@@ -3891,7 +5418,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusNotifierValue_Menu_Properties); i {
 			case 0:
 				return &v.state
@@ -3903,7 +5430,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotificationValue_Hint); i {
 			case 0:
 				return &v.state
@@ -3915,7 +5442,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotificationValue_Action); i {
 			case 0:
 				return &v.state
@@ -3927,7 +5454,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_event_v1_event_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_event_v1_event_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotificationValue_Pixmap); i {
 			case 0:
 				return &v.state
@@ -3946,7 +5473,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hyprpanel_event_v1_event_proto_rawDesc,
 			NumEnums:      4,
-			NumMessages:   36,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
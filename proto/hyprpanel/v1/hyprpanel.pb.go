@@ -368,11 +368,12 @@ type PanelServiceInitRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Host       uint32      `protobuf:"varint,1,opt,name=host,proto3" json:"host,omitempty"`
-	Id         string      `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	LogLevel   v1.LogLevel `protobuf:"varint,3,opt,name=log_level,json=logLevel,proto3,enum=hyprpanel.config.v1.LogLevel" json:"log_level,omitempty"`
-	Config     *v1.Panel   `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	Stylesheet []byte      `protobuf:"bytes,5,opt,name=stylesheet,proto3" json:"stylesheet,omitempty"`
+	Host         uint32                 `protobuf:"varint,1,opt,name=host,proto3" json:"host,omitempty"`
+	Id           string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	LogLevel     v1.LogLevel            `protobuf:"varint,3,opt,name=log_level,json=logLevel,proto3,enum=hyprpanel.config.v1.LogLevel" json:"log_level,omitempty"`
+	Config       *v1.Panel              `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	Stylesheet   []byte                 `protobuf:"bytes,5,opt,name=stylesheet,proto3" json:"stylesheet,omitempty"`
+	HyprSnapshot *v11.HyprSnapshotValue `protobuf:"bytes,6,opt,name=hypr_snapshot,json=hyprSnapshot,proto3" json:"hypr_snapshot,omitempty"` // Hyprland state to answer queries from when replaying a recording, the panel does not connect to Hyprland if set.
 }
 
 func (x *PanelServiceInitRequest) Reset() {
@@ -442,10 +443,20 @@ func (x *PanelServiceInitRequest) GetStylesheet() []byte {
 	return nil
 }
 
+func (x *PanelServiceInitRequest) GetHyprSnapshot() *v11.HyprSnapshotValue {
+	if x != nil {
+		return x.HyprSnapshot
+	}
+	return nil
+}
+
 type PanelServiceInitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Kinds        []v11.EventKind `protobuf:"varint,1,rep,packed,name=kinds,proto3,enum=hyprpanel.event.v1.EventKind" json:"kinds,omitempty"` // event kinds consumed by the panel, only delivered if filter_events is set.
+	FilterEvents bool            `protobuf:"varint,2,opt,name=filter_events,json=filterEvents,proto3" json:"filter_events,omitempty"`        // deliver only the events listed in kinds, which may be empty to deliver no events. All events are delivered if unset.
 }
 
 func (x *PanelServiceInitResponse) Reset() {
@@ -480,16 +491,31 @@ func (*PanelServiceInitResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{3}
 }
 
-type PanelServiceNotifyRequest struct {
+func (x *PanelServiceInitResponse) GetKinds() []v11.EventKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *PanelServiceInitResponse) GetFilterEvents() bool {
+	if x != nil {
+		return x.FilterEvents
+	}
+	return false
+}
+
+type PanelServiceEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event *v11.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Seq    uint64       `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`      // sequence number of the batch, incremented for each batch sent.
+	Events []*v11.Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"` // events to deliver, in order.
 }
 
-func (x *PanelServiceNotifyRequest) Reset() {
-	*x = PanelServiceNotifyRequest{}
+func (x *PanelServiceEventsRequest) Reset() {
+	*x = PanelServiceEventsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,13 +523,13 @@ func (x *PanelServiceNotifyRequest) Reset() {
 	}
 }
 
-func (x *PanelServiceNotifyRequest) String() string {
+func (x *PanelServiceEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PanelServiceNotifyRequest) ProtoMessage() {}
+func (*PanelServiceEventsRequest) ProtoMessage() {}
 
-func (x *PanelServiceNotifyRequest) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -515,26 +541,36 @@ func (x *PanelServiceNotifyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PanelServiceNotifyRequest.ProtoReflect.Descriptor instead.
-func (*PanelServiceNotifyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceEventsRequest.ProtoReflect.Descriptor instead.
+func (*PanelServiceEventsRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PanelServiceNotifyRequest) GetEvent() *v11.Event {
+func (x *PanelServiceEventsRequest) GetSeq() uint64 {
 	if x != nil {
-		return x.Event
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *PanelServiceEventsRequest) GetEvents() []*v11.Event {
+	if x != nil {
+		return x.Events
 	}
 	return nil
 }
 
-type PanelServiceNotifyResponse struct {
+type PanelServiceEventsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Seq   uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`    // sequence number of the acknowledged batch.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // delivery errors for the batch, empty on success.
 }
 
-func (x *PanelServiceNotifyResponse) Reset() {
-	*x = PanelServiceNotifyResponse{}
+func (x *PanelServiceEventsResponse) Reset() {
+	*x = PanelServiceEventsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,13 +578,13 @@ func (x *PanelServiceNotifyResponse) Reset() {
 	}
 }
 
-func (x *PanelServiceNotifyResponse) String() string {
+func (x *PanelServiceEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PanelServiceNotifyResponse) ProtoMessage() {}
+func (*PanelServiceEventsResponse) ProtoMessage() {}
 
-func (x *PanelServiceNotifyResponse) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -560,11 +596,25 @@ func (x *PanelServiceNotifyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PanelServiceNotifyResponse.ProtoReflect.Descriptor instead.
-func (*PanelServiceNotifyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceEventsResponse.ProtoReflect.Descriptor instead.
+func (*PanelServiceEventsResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{5}
 }
 
+func (x *PanelServiceEventsResponse) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *PanelServiceEventsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type PanelServiceNotificationCloseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -650,14 +700,16 @@ func (*PanelServiceNotificationCloseResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{7}
 }
 
-type PanelServiceCloseRequest struct {
+type PanelServiceSetVisibleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Visible bool `protobuf:"varint,1,opt,name=visible,proto3" json:"visible,omitempty"`
 }
 
-func (x *PanelServiceCloseRequest) Reset() {
-	*x = PanelServiceCloseRequest{}
+func (x *PanelServiceSetVisibleRequest) Reset() {
+	*x = PanelServiceSetVisibleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -665,13 +717,13 @@ func (x *PanelServiceCloseRequest) Reset() {
 	}
 }
 
-func (x *PanelServiceCloseRequest) String() string {
+func (x *PanelServiceSetVisibleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PanelServiceCloseRequest) ProtoMessage() {}
+func (*PanelServiceSetVisibleRequest) ProtoMessage() {}
 
-func (x *PanelServiceCloseRequest) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceSetVisibleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -683,19 +735,26 @@ func (x *PanelServiceCloseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PanelServiceCloseRequest.ProtoReflect.Descriptor instead.
-func (*PanelServiceCloseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceSetVisibleRequest.ProtoReflect.Descriptor instead.
+func (*PanelServiceSetVisibleRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{8}
 }
 
-type PanelServiceCloseResponse struct {
+func (x *PanelServiceSetVisibleRequest) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
+type PanelServiceSetVisibleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *PanelServiceCloseResponse) Reset() {
-	*x = PanelServiceCloseResponse{}
+func (x *PanelServiceSetVisibleResponse) Reset() {
+	*x = PanelServiceSetVisibleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -703,13 +762,13 @@ func (x *PanelServiceCloseResponse) Reset() {
 	}
 }
 
-func (x *PanelServiceCloseResponse) String() string {
+func (x *PanelServiceSetVisibleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PanelServiceCloseResponse) ProtoMessage() {}
+func (*PanelServiceSetVisibleResponse) ProtoMessage() {}
 
-func (x *PanelServiceCloseResponse) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceSetVisibleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -721,21 +780,21 @@ func (x *PanelServiceCloseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PanelServiceCloseResponse.ProtoReflect.Descriptor instead.
-func (*PanelServiceCloseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceSetVisibleResponse.ProtoReflect.Descriptor instead.
+func (*PanelServiceSetVisibleResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{9}
 }
 
-type HostServiceExecRequest struct {
+type PanelServiceSetLogLevelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Action *AppInfo_Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	LogLevel v1.LogLevel `protobuf:"varint,1,opt,name=log_level,json=logLevel,proto3,enum=hyprpanel.config.v1.LogLevel" json:"log_level,omitempty"`
 }
 
-func (x *HostServiceExecRequest) Reset() {
-	*x = HostServiceExecRequest{}
+func (x *PanelServiceSetLogLevelRequest) Reset() {
+	*x = PanelServiceSetLogLevelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -743,13 +802,13 @@ func (x *HostServiceExecRequest) Reset() {
 	}
 }
 
-func (x *HostServiceExecRequest) String() string {
+func (x *PanelServiceSetLogLevelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceExecRequest) ProtoMessage() {}
+func (*PanelServiceSetLogLevelRequest) ProtoMessage() {}
 
-func (x *HostServiceExecRequest) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceSetLogLevelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -761,26 +820,26 @@ func (x *HostServiceExecRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceExecRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceExecRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceSetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*PanelServiceSetLogLevelRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *HostServiceExecRequest) GetAction() *AppInfo_Action {
+func (x *PanelServiceSetLogLevelRequest) GetLogLevel() v1.LogLevel {
 	if x != nil {
-		return x.Action
+		return x.LogLevel
 	}
-	return nil
+	return v1.LogLevel(0)
 }
 
-type HostServiceExecResponse struct {
+type PanelServiceSetLogLevelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceExecResponse) Reset() {
-	*x = HostServiceExecResponse{}
+func (x *PanelServiceSetLogLevelResponse) Reset() {
+	*x = PanelServiceSetLogLevelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -788,13 +847,13 @@ func (x *HostServiceExecResponse) Reset() {
 	}
 }
 
-func (x *HostServiceExecResponse) String() string {
+func (x *PanelServiceSetLogLevelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceExecResponse) ProtoMessage() {}
+func (*PanelServiceSetLogLevelResponse) ProtoMessage() {}
 
-func (x *HostServiceExecResponse) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceSetLogLevelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -806,21 +865,21 @@ func (x *HostServiceExecResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceExecResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceExecResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceSetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*PanelServiceSetLogLevelResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{11}
 }
 
-type HostServiceFindApplicationRequest struct {
+type PanelServiceUpdateStyleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Stylesheet []byte `protobuf:"bytes,1,opt,name=stylesheet,proto3" json:"stylesheet,omitempty"`
 }
 
-func (x *HostServiceFindApplicationRequest) Reset() {
-	*x = HostServiceFindApplicationRequest{}
+func (x *PanelServiceUpdateStyleRequest) Reset() {
+	*x = PanelServiceUpdateStyleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -828,13 +887,13 @@ func (x *HostServiceFindApplicationRequest) Reset() {
 	}
 }
 
-func (x *HostServiceFindApplicationRequest) String() string {
+func (x *PanelServiceUpdateStyleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceFindApplicationRequest) ProtoMessage() {}
+func (*PanelServiceUpdateStyleRequest) ProtoMessage() {}
 
-func (x *HostServiceFindApplicationRequest) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceUpdateStyleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -846,28 +905,26 @@ func (x *HostServiceFindApplicationRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceFindApplicationRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceFindApplicationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceUpdateStyleRequest.ProtoReflect.Descriptor instead.
+func (*PanelServiceUpdateStyleRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *HostServiceFindApplicationRequest) GetQuery() string {
+func (x *PanelServiceUpdateStyleRequest) GetStylesheet() []byte {
 	if x != nil {
-		return x.Query
+		return x.Stylesheet
 	}
-	return ""
+	return nil
 }
 
-type HostServiceFindApplicationResponse struct {
+type PanelServiceUpdateStyleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	AppInfo *AppInfo `protobuf:"bytes,1,opt,name=app_info,json=appInfo,proto3" json:"app_info,omitempty"`
 }
 
-func (x *HostServiceFindApplicationResponse) Reset() {
-	*x = HostServiceFindApplicationResponse{}
+func (x *PanelServiceUpdateStyleResponse) Reset() {
+	*x = PanelServiceUpdateStyleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -875,13 +932,13 @@ func (x *HostServiceFindApplicationResponse) Reset() {
 	}
 }
 
-func (x *HostServiceFindApplicationResponse) String() string {
+func (x *PanelServiceUpdateStyleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceFindApplicationResponse) ProtoMessage() {}
+func (*PanelServiceUpdateStyleResponse) ProtoMessage() {}
 
-func (x *HostServiceFindApplicationResponse) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceUpdateStyleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -893,30 +950,19 @@ func (x *HostServiceFindApplicationResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceFindApplicationResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceFindApplicationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceUpdateStyleResponse.ProtoReflect.Descriptor instead.
+func (*PanelServiceUpdateStyleResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *HostServiceFindApplicationResponse) GetAppInfo() *AppInfo {
-	if x != nil {
-		return x.AppInfo
-	}
-	return nil
-}
-
-type HostServiceSystrayActivateRequest struct {
+type PanelServiceCloseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	BusName string `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
-	X       int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
-	Y       int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
 }
 
-func (x *HostServiceSystrayActivateRequest) Reset() {
-	*x = HostServiceSystrayActivateRequest{}
+func (x *PanelServiceCloseRequest) Reset() {
+	*x = PanelServiceCloseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -924,13 +970,13 @@ func (x *HostServiceSystrayActivateRequest) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayActivateRequest) String() string {
+func (x *PanelServiceCloseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayActivateRequest) ProtoMessage() {}
+func (*PanelServiceCloseRequest) ProtoMessage() {}
 
-func (x *HostServiceSystrayActivateRequest) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceCloseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -942,40 +988,19 @@ func (x *HostServiceSystrayActivateRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayActivateRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayActivateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceCloseRequest.ProtoReflect.Descriptor instead.
+func (*PanelServiceCloseRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *HostServiceSystrayActivateRequest) GetBusName() string {
-	if x != nil {
-		return x.BusName
-	}
-	return ""
-}
-
-func (x *HostServiceSystrayActivateRequest) GetX() int32 {
-	if x != nil {
-		return x.X
-	}
-	return 0
-}
-
-func (x *HostServiceSystrayActivateRequest) GetY() int32 {
-	if x != nil {
-		return x.Y
-	}
-	return 0
-}
-
-type HostServiceSystrayActivateResponse struct {
+type PanelServiceCloseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceSystrayActivateResponse) Reset() {
-	*x = HostServiceSystrayActivateResponse{}
+func (x *PanelServiceCloseResponse) Reset() {
+	*x = PanelServiceCloseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -983,13 +1008,13 @@ func (x *HostServiceSystrayActivateResponse) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayActivateResponse) String() string {
+func (x *PanelServiceCloseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayActivateResponse) ProtoMessage() {}
+func (*PanelServiceCloseResponse) ProtoMessage() {}
 
-func (x *HostServiceSystrayActivateResponse) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceCloseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1001,23 +1026,21 @@ func (x *HostServiceSystrayActivateResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayActivateResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayActivateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceCloseResponse.ProtoReflect.Descriptor instead.
+func (*PanelServiceCloseResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{15}
 }
 
-type HostServiceSystraySecondaryActivateRequest struct {
+type HostServiceExecRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BusName string `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
-	X       int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
-	Y       int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	Action *AppInfo_Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
 }
 
-func (x *HostServiceSystraySecondaryActivateRequest) Reset() {
-	*x = HostServiceSystraySecondaryActivateRequest{}
+func (x *HostServiceExecRequest) Reset() {
+	*x = HostServiceExecRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,13 +1048,13 @@ func (x *HostServiceSystraySecondaryActivateRequest) Reset() {
 	}
 }
 
-func (x *HostServiceSystraySecondaryActivateRequest) String() string {
+func (x *HostServiceExecRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystraySecondaryActivateRequest) ProtoMessage() {}
+func (*HostServiceExecRequest) ProtoMessage() {}
 
-func (x *HostServiceSystraySecondaryActivateRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceExecRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1043,40 +1066,26 @@ func (x *HostServiceSystraySecondaryActivateRequest) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystraySecondaryActivateRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceSystraySecondaryActivateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceExecRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceExecRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *HostServiceSystraySecondaryActivateRequest) GetBusName() string {
-	if x != nil {
-		return x.BusName
-	}
-	return ""
-}
-
-func (x *HostServiceSystraySecondaryActivateRequest) GetX() int32 {
-	if x != nil {
-		return x.X
-	}
-	return 0
-}
-
-func (x *HostServiceSystraySecondaryActivateRequest) GetY() int32 {
+func (x *HostServiceExecRequest) GetAction() *AppInfo_Action {
 	if x != nil {
-		return x.Y
+		return x.Action
 	}
-	return 0
+	return nil
 }
 
-type HostServiceSystraySecondaryActivateResponse struct {
+type HostServiceExecResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceSystraySecondaryActivateResponse) Reset() {
-	*x = HostServiceSystraySecondaryActivateResponse{}
+func (x *HostServiceExecResponse) Reset() {
+	*x = HostServiceExecResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1084,13 +1093,13 @@ func (x *HostServiceSystraySecondaryActivateResponse) Reset() {
 	}
 }
 
-func (x *HostServiceSystraySecondaryActivateResponse) String() string {
+func (x *HostServiceExecResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystraySecondaryActivateResponse) ProtoMessage() {}
+func (*HostServiceExecResponse) ProtoMessage() {}
 
-func (x *HostServiceSystraySecondaryActivateResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceExecResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1102,23 +1111,21 @@ func (x *HostServiceSystraySecondaryActivateResponse) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystraySecondaryActivateResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceSystraySecondaryActivateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceExecResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceExecResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{17}
 }
 
-type HostServiceSystrayScrollRequest struct {
+type HostServiceFindApplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BusName     string                   `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
-	Delta       int32                    `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
-	Orientation SystrayScrollOrientation `protobuf:"varint,3,opt,name=orientation,proto3,enum=hyprpanel.v1.SystrayScrollOrientation" json:"orientation,omitempty"`
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *HostServiceSystrayScrollRequest) Reset() {
-	*x = HostServiceSystrayScrollRequest{}
+func (x *HostServiceFindApplicationRequest) Reset() {
+	*x = HostServiceFindApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1126,13 +1133,13 @@ func (x *HostServiceSystrayScrollRequest) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayScrollRequest) String() string {
+func (x *HostServiceFindApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayScrollRequest) ProtoMessage() {}
+func (*HostServiceFindApplicationRequest) ProtoMessage() {}
 
-func (x *HostServiceSystrayScrollRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceFindApplicationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1144,40 +1151,28 @@ func (x *HostServiceSystrayScrollRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayScrollRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayScrollRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceFindApplicationRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceFindApplicationRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *HostServiceSystrayScrollRequest) GetBusName() string {
+func (x *HostServiceFindApplicationRequest) GetQuery() string {
 	if x != nil {
-		return x.BusName
+		return x.Query
 	}
 	return ""
 }
 
-func (x *HostServiceSystrayScrollRequest) GetDelta() int32 {
-	if x != nil {
-		return x.Delta
-	}
-	return 0
-}
+type HostServiceFindApplicationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *HostServiceSystrayScrollRequest) GetOrientation() SystrayScrollOrientation {
-	if x != nil {
-		return x.Orientation
-	}
-	return SystrayScrollOrientation_SYSTRAY_SCROLL_ORIENTATION_UNSPECIFIED
-}
-
-type HostServiceSystrayScrollResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	AppInfo *AppInfo `protobuf:"bytes,1,opt,name=app_info,json=appInfo,proto3" json:"app_info,omitempty"`
 }
 
-func (x *HostServiceSystrayScrollResponse) Reset() {
-	*x = HostServiceSystrayScrollResponse{}
+func (x *HostServiceFindApplicationResponse) Reset() {
+	*x = HostServiceFindApplicationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1185,13 +1180,13 @@ func (x *HostServiceSystrayScrollResponse) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayScrollResponse) String() string {
+func (x *HostServiceFindApplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayScrollResponse) ProtoMessage() {}
+func (*HostServiceFindApplicationResponse) ProtoMessage() {}
 
-func (x *HostServiceSystrayScrollResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceFindApplicationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1203,12 +1198,19 @@ func (x *HostServiceSystrayScrollResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayScrollResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayScrollResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceFindApplicationResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceFindApplicationResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{19}
 }
 
-type HostServiceSystrayMenuContextActivateRequest struct {
+func (x *HostServiceFindApplicationResponse) GetAppInfo() *AppInfo {
+	if x != nil {
+		return x.AppInfo
+	}
+	return nil
+}
+
+type HostServiceSystrayActivateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1218,8 +1220,8 @@ type HostServiceSystrayMenuContextActivateRequest struct {
 	Y       int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
 }
 
-func (x *HostServiceSystrayMenuContextActivateRequest) Reset() {
-	*x = HostServiceSystrayMenuContextActivateRequest{}
+func (x *HostServiceSystrayActivateRequest) Reset() {
+	*x = HostServiceSystrayActivateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1227,13 +1229,13 @@ func (x *HostServiceSystrayMenuContextActivateRequest) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayMenuContextActivateRequest) String() string {
+func (x *HostServiceSystrayActivateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayMenuContextActivateRequest) ProtoMessage() {}
+func (*HostServiceSystrayActivateRequest) ProtoMessage() {}
 
-func (x *HostServiceSystrayMenuContextActivateRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayActivateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1245,40 +1247,40 @@ func (x *HostServiceSystrayMenuContextActivateRequest) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayMenuContextActivateRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayMenuContextActivateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayActivateRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayActivateRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *HostServiceSystrayMenuContextActivateRequest) GetBusName() string {
+func (x *HostServiceSystrayActivateRequest) GetBusName() string {
 	if x != nil {
 		return x.BusName
 	}
 	return ""
 }
 
-func (x *HostServiceSystrayMenuContextActivateRequest) GetX() int32 {
+func (x *HostServiceSystrayActivateRequest) GetX() int32 {
 	if x != nil {
 		return x.X
 	}
 	return 0
 }
 
-func (x *HostServiceSystrayMenuContextActivateRequest) GetY() int32 {
+func (x *HostServiceSystrayActivateRequest) GetY() int32 {
 	if x != nil {
 		return x.Y
 	}
 	return 0
 }
 
-type HostServiceSystrayMenuContextActivateResponse struct {
+type HostServiceSystrayActivateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceSystrayMenuContextActivateResponse) Reset() {
-	*x = HostServiceSystrayMenuContextActivateResponse{}
+func (x *HostServiceSystrayActivateResponse) Reset() {
+	*x = HostServiceSystrayActivateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1286,13 +1288,13 @@ func (x *HostServiceSystrayMenuContextActivateResponse) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayMenuContextActivateResponse) String() string {
+func (x *HostServiceSystrayActivateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayMenuContextActivateResponse) ProtoMessage() {}
+func (*HostServiceSystrayActivateResponse) ProtoMessage() {}
 
-func (x *HostServiceSystrayMenuContextActivateResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayActivateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1304,22 +1306,23 @@ func (x *HostServiceSystrayMenuContextActivateResponse) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayMenuContextActivateResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayMenuContextActivateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayActivateResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayActivateResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{21}
 }
 
-type HostServiceSystrayMenuAboutToShowRequest struct {
+type HostServiceSystraySecondaryActivateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BusName    string `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
-	MenuItemId string `protobuf:"bytes,2,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`
+	BusName string `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
+	X       int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y       int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
 }
 
-func (x *HostServiceSystrayMenuAboutToShowRequest) Reset() {
-	*x = HostServiceSystrayMenuAboutToShowRequest{}
+func (x *HostServiceSystraySecondaryActivateRequest) Reset() {
+	*x = HostServiceSystraySecondaryActivateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1327,13 +1330,13 @@ func (x *HostServiceSystrayMenuAboutToShowRequest) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayMenuAboutToShowRequest) String() string {
+func (x *HostServiceSystraySecondaryActivateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayMenuAboutToShowRequest) ProtoMessage() {}
+func (*HostServiceSystraySecondaryActivateRequest) ProtoMessage() {}
 
-func (x *HostServiceSystrayMenuAboutToShowRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystraySecondaryActivateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1345,33 +1348,40 @@ func (x *HostServiceSystrayMenuAboutToShowRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayMenuAboutToShowRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayMenuAboutToShowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystraySecondaryActivateRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceSystraySecondaryActivateRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *HostServiceSystrayMenuAboutToShowRequest) GetBusName() string {
+func (x *HostServiceSystraySecondaryActivateRequest) GetBusName() string {
 	if x != nil {
 		return x.BusName
 	}
 	return ""
 }
 
-func (x *HostServiceSystrayMenuAboutToShowRequest) GetMenuItemId() string {
+func (x *HostServiceSystraySecondaryActivateRequest) GetX() int32 {
 	if x != nil {
-		return x.MenuItemId
+		return x.X
 	}
-	return ""
+	return 0
 }
 
-type HostServiceSystrayMenuAboutToShowResponse struct {
+func (x *HostServiceSystraySecondaryActivateRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type HostServiceSystraySecondaryActivateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceSystrayMenuAboutToShowResponse) Reset() {
-	*x = HostServiceSystrayMenuAboutToShowResponse{}
+func (x *HostServiceSystraySecondaryActivateResponse) Reset() {
+	*x = HostServiceSystraySecondaryActivateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1379,13 +1389,13 @@ func (x *HostServiceSystrayMenuAboutToShowResponse) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayMenuAboutToShowResponse) String() string {
+func (x *HostServiceSystraySecondaryActivateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayMenuAboutToShowResponse) ProtoMessage() {}
+func (*HostServiceSystraySecondaryActivateResponse) ProtoMessage() {}
 
-func (x *HostServiceSystrayMenuAboutToShowResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystraySecondaryActivateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1397,25 +1407,23 @@ func (x *HostServiceSystrayMenuAboutToShowResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayMenuAboutToShowResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayMenuAboutToShowResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystraySecondaryActivateResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceSystraySecondaryActivateResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{23}
 }
 
-type HostServiceSystrayMenuEventRequest struct {
+type HostServiceSystrayScrollRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BusName   string           `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
-	Id        int32            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	EventId   SystrayMenuEvent `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3,enum=hyprpanel.v1.SystrayMenuEvent" json:"event_id,omitempty"`
-	Data      *anypb.Any       `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	Timestamp uint32           `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	BusName     string                   `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
+	Delta       int32                    `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	Orientation SystrayScrollOrientation `protobuf:"varint,3,opt,name=orientation,proto3,enum=hyprpanel.v1.SystrayScrollOrientation" json:"orientation,omitempty"`
 }
 
-func (x *HostServiceSystrayMenuEventRequest) Reset() {
-	*x = HostServiceSystrayMenuEventRequest{}
+func (x *HostServiceSystrayScrollRequest) Reset() {
+	*x = HostServiceSystrayScrollRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1423,13 +1431,13 @@ func (x *HostServiceSystrayMenuEventRequest) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayMenuEventRequest) String() string {
+func (x *HostServiceSystrayScrollRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayMenuEventRequest) ProtoMessage() {}
+func (*HostServiceSystrayScrollRequest) ProtoMessage() {}
 
-func (x *HostServiceSystrayMenuEventRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayScrollRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1441,54 +1449,40 @@ func (x *HostServiceSystrayMenuEventRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayMenuEventRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayMenuEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayScrollRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayScrollRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *HostServiceSystrayMenuEventRequest) GetBusName() string {
+func (x *HostServiceSystrayScrollRequest) GetBusName() string {
 	if x != nil {
 		return x.BusName
 	}
 	return ""
 }
 
-func (x *HostServiceSystrayMenuEventRequest) GetId() int32 {
+func (x *HostServiceSystrayScrollRequest) GetDelta() int32 {
 	if x != nil {
-		return x.Id
+		return x.Delta
 	}
 	return 0
 }
 
-func (x *HostServiceSystrayMenuEventRequest) GetEventId() SystrayMenuEvent {
-	if x != nil {
-		return x.EventId
-	}
-	return SystrayMenuEvent_SYSTRAY_MENU_EVENT_UNSPECIFIED
-}
-
-func (x *HostServiceSystrayMenuEventRequest) GetData() *anypb.Any {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *HostServiceSystrayMenuEventRequest) GetTimestamp() uint32 {
+func (x *HostServiceSystrayScrollRequest) GetOrientation() SystrayScrollOrientation {
 	if x != nil {
-		return x.Timestamp
+		return x.Orientation
 	}
-	return 0
+	return SystrayScrollOrientation_SYSTRAY_SCROLL_ORIENTATION_UNSPECIFIED
 }
 
-type HostServiceSystrayMenuEventResponse struct {
+type HostServiceSystrayScrollResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceSystrayMenuEventResponse) Reset() {
-	*x = HostServiceSystrayMenuEventResponse{}
+func (x *HostServiceSystrayScrollResponse) Reset() {
+	*x = HostServiceSystrayScrollResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1496,13 +1490,13 @@ func (x *HostServiceSystrayMenuEventResponse) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayMenuEventResponse) String() string {
+func (x *HostServiceSystrayScrollResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayMenuEventResponse) ProtoMessage() {}
+func (*HostServiceSystrayScrollResponse) ProtoMessage() {}
 
-func (x *HostServiceSystrayMenuEventResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayScrollResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1514,22 +1508,23 @@ func (x *HostServiceSystrayMenuEventResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayMenuEventResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayMenuEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayScrollResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayScrollResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{25}
 }
 
-type HostServiceNotificationClosedRequest struct {
+type HostServiceSystrayMenuContextActivateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Reason NotificationClosedReason `protobuf:"varint,2,opt,name=reason,proto3,enum=hyprpanel.v1.NotificationClosedReason" json:"reason,omitempty"`
+	BusName string `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
+	X       int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y       int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
 }
 
-func (x *HostServiceNotificationClosedRequest) Reset() {
-	*x = HostServiceNotificationClosedRequest{}
+func (x *HostServiceSystrayMenuContextActivateRequest) Reset() {
+	*x = HostServiceSystrayMenuContextActivateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1537,13 +1532,13 @@ func (x *HostServiceNotificationClosedRequest) Reset() {
 	}
 }
 
-func (x *HostServiceNotificationClosedRequest) String() string {
+func (x *HostServiceSystrayMenuContextActivateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceNotificationClosedRequest) ProtoMessage() {}
+func (*HostServiceSystrayMenuContextActivateRequest) ProtoMessage() {}
 
-func (x *HostServiceNotificationClosedRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayMenuContextActivateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1555,33 +1550,40 @@ func (x *HostServiceNotificationClosedRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceNotificationClosedRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceNotificationClosedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayMenuContextActivateRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayMenuContextActivateRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *HostServiceNotificationClosedRequest) GetId() uint32 {
+func (x *HostServiceSystrayMenuContextActivateRequest) GetBusName() string {
 	if x != nil {
-		return x.Id
+		return x.BusName
+	}
+	return ""
+}
+
+func (x *HostServiceSystrayMenuContextActivateRequest) GetX() int32 {
+	if x != nil {
+		return x.X
 	}
 	return 0
 }
 
-func (x *HostServiceNotificationClosedRequest) GetReason() NotificationClosedReason {
+func (x *HostServiceSystrayMenuContextActivateRequest) GetY() int32 {
 	if x != nil {
-		return x.Reason
+		return x.Y
 	}
-	return NotificationClosedReason_NOTIFICATION_CLOSED_REASON_UNSPECIFIED
+	return 0
 }
 
-type HostServiceNotificationClosedResponse struct {
+type HostServiceSystrayMenuContextActivateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceNotificationClosedResponse) Reset() {
-	*x = HostServiceNotificationClosedResponse{}
+func (x *HostServiceSystrayMenuContextActivateResponse) Reset() {
+	*x = HostServiceSystrayMenuContextActivateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1589,13 +1591,13 @@ func (x *HostServiceNotificationClosedResponse) Reset() {
 	}
 }
 
-func (x *HostServiceNotificationClosedResponse) String() string {
+func (x *HostServiceSystrayMenuContextActivateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceNotificationClosedResponse) ProtoMessage() {}
+func (*HostServiceSystrayMenuContextActivateResponse) ProtoMessage() {}
 
-func (x *HostServiceNotificationClosedResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayMenuContextActivateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1607,22 +1609,22 @@ func (x *HostServiceNotificationClosedResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceNotificationClosedResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceNotificationClosedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayMenuContextActivateResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayMenuContextActivateResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{27}
 }
 
-type HostServiceNotificationActionRequest struct {
+type HostServiceSystrayMenuAboutToShowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ActionKey string `protobuf:"bytes,2,opt,name=action_key,json=actionKey,proto3" json:"action_key,omitempty"`
+	BusName    string `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
+	MenuItemId string `protobuf:"bytes,2,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`
 }
 
-func (x *HostServiceNotificationActionRequest) Reset() {
-	*x = HostServiceNotificationActionRequest{}
+func (x *HostServiceSystrayMenuAboutToShowRequest) Reset() {
+	*x = HostServiceSystrayMenuAboutToShowRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1630,13 +1632,13 @@ func (x *HostServiceNotificationActionRequest) Reset() {
 	}
 }
 
-func (x *HostServiceNotificationActionRequest) String() string {
+func (x *HostServiceSystrayMenuAboutToShowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceNotificationActionRequest) ProtoMessage() {}
+func (*HostServiceSystrayMenuAboutToShowRequest) ProtoMessage() {}
 
-func (x *HostServiceNotificationActionRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayMenuAboutToShowRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1648,33 +1650,33 @@ func (x *HostServiceNotificationActionRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceNotificationActionRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceNotificationActionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayMenuAboutToShowRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayMenuAboutToShowRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *HostServiceNotificationActionRequest) GetId() uint32 {
+func (x *HostServiceSystrayMenuAboutToShowRequest) GetBusName() string {
 	if x != nil {
-		return x.Id
+		return x.BusName
 	}
-	return 0
+	return ""
 }
 
-func (x *HostServiceNotificationActionRequest) GetActionKey() string {
+func (x *HostServiceSystrayMenuAboutToShowRequest) GetMenuItemId() string {
 	if x != nil {
-		return x.ActionKey
+		return x.MenuItemId
 	}
 	return ""
 }
 
-type HostServiceNotificationActionResponse struct {
+type HostServiceSystrayMenuAboutToShowResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceNotificationActionResponse) Reset() {
-	*x = HostServiceNotificationActionResponse{}
+func (x *HostServiceSystrayMenuAboutToShowResponse) Reset() {
+	*x = HostServiceSystrayMenuAboutToShowResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1682,13 +1684,13 @@ func (x *HostServiceNotificationActionResponse) Reset() {
 	}
 }
 
-func (x *HostServiceNotificationActionResponse) String() string {
+func (x *HostServiceSystrayMenuAboutToShowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceNotificationActionResponse) ProtoMessage() {}
+func (*HostServiceSystrayMenuAboutToShowResponse) ProtoMessage() {}
 
-func (x *HostServiceNotificationActionResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayMenuAboutToShowResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1700,22 +1702,25 @@ func (x *HostServiceNotificationActionResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceNotificationActionResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceNotificationActionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayMenuAboutToShowResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayMenuAboutToShowResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{29}
 }
 
-type HostServiceAudioSinkVolumeAdjustRequest struct {
+type HostServiceSystrayMenuEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Direction v11.Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
+	BusName   string           `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
+	Id        int32            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	EventId   SystrayMenuEvent `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3,enum=hyprpanel.v1.SystrayMenuEvent" json:"event_id,omitempty"`
+	Data      *anypb.Any       `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp uint32           `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *HostServiceAudioSinkVolumeAdjustRequest) Reset() {
-	*x = HostServiceAudioSinkVolumeAdjustRequest{}
+func (x *HostServiceSystrayMenuEventRequest) Reset() {
+	*x = HostServiceSystrayMenuEventRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1723,13 +1728,13 @@ func (x *HostServiceAudioSinkVolumeAdjustRequest) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSinkVolumeAdjustRequest) String() string {
+func (x *HostServiceSystrayMenuEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSinkVolumeAdjustRequest) ProtoMessage() {}
+func (*HostServiceSystrayMenuEventRequest) ProtoMessage() {}
 
-func (x *HostServiceAudioSinkVolumeAdjustRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayMenuEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1741,33 +1746,54 @@ func (x *HostServiceAudioSinkVolumeAdjustRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSinkVolumeAdjustRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSinkVolumeAdjustRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayMenuEventRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayMenuEventRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *HostServiceAudioSinkVolumeAdjustRequest) GetId() string {
+func (x *HostServiceSystrayMenuEventRequest) GetBusName() string {
 	if x != nil {
-		return x.Id
+		return x.BusName
 	}
 	return ""
 }
 
-func (x *HostServiceAudioSinkVolumeAdjustRequest) GetDirection() v11.Direction {
+func (x *HostServiceSystrayMenuEventRequest) GetId() int32 {
 	if x != nil {
-		return x.Direction
+		return x.Id
 	}
-	return v11.Direction(0)
+	return 0
 }
 
-type HostServiceAudioSinkVolumeAdjustResponse struct {
+func (x *HostServiceSystrayMenuEventRequest) GetEventId() SystrayMenuEvent {
+	if x != nil {
+		return x.EventId
+	}
+	return SystrayMenuEvent_SYSTRAY_MENU_EVENT_UNSPECIFIED
+}
+
+func (x *HostServiceSystrayMenuEventRequest) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *HostServiceSystrayMenuEventRequest) GetTimestamp() uint32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type HostServiceSystrayMenuEventResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceAudioSinkVolumeAdjustResponse) Reset() {
-	*x = HostServiceAudioSinkVolumeAdjustResponse{}
+func (x *HostServiceSystrayMenuEventResponse) Reset() {
+	*x = HostServiceSystrayMenuEventResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1775,13 +1801,13 @@ func (x *HostServiceAudioSinkVolumeAdjustResponse) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSinkVolumeAdjustResponse) String() string {
+func (x *HostServiceSystrayMenuEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSinkVolumeAdjustResponse) ProtoMessage() {}
+func (*HostServiceSystrayMenuEventResponse) ProtoMessage() {}
 
-func (x *HostServiceAudioSinkVolumeAdjustResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayMenuEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1793,21 +1819,22 @@ func (x *HostServiceAudioSinkVolumeAdjustResponse) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSinkVolumeAdjustResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSinkVolumeAdjustResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayMenuEventResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayMenuEventResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{31}
 }
 
-type HostServiceAudioSinkMuteToggleRequest struct {
+type HostServiceNotificationClosedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id     uint32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason NotificationClosedReason `protobuf:"varint,2,opt,name=reason,proto3,enum=hyprpanel.v1.NotificationClosedReason" json:"reason,omitempty"`
 }
 
-func (x *HostServiceAudioSinkMuteToggleRequest) Reset() {
-	*x = HostServiceAudioSinkMuteToggleRequest{}
+func (x *HostServiceNotificationClosedRequest) Reset() {
+	*x = HostServiceNotificationClosedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1815,13 +1842,13 @@ func (x *HostServiceAudioSinkMuteToggleRequest) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSinkMuteToggleRequest) String() string {
+func (x *HostServiceNotificationClosedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSinkMuteToggleRequest) ProtoMessage() {}
+func (*HostServiceNotificationClosedRequest) ProtoMessage() {}
 
-func (x *HostServiceAudioSinkMuteToggleRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceNotificationClosedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1833,26 +1860,33 @@ func (x *HostServiceAudioSinkMuteToggleRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSinkMuteToggleRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSinkMuteToggleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceNotificationClosedRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceNotificationClosedRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *HostServiceAudioSinkMuteToggleRequest) GetId() string {
+func (x *HostServiceNotificationClosedRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type HostServiceAudioSinkMuteToggleResponse struct {
+func (x *HostServiceNotificationClosedRequest) GetReason() NotificationClosedReason {
+	if x != nil {
+		return x.Reason
+	}
+	return NotificationClosedReason_NOTIFICATION_CLOSED_REASON_UNSPECIFIED
+}
+
+type HostServiceNotificationClosedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceAudioSinkMuteToggleResponse) Reset() {
-	*x = HostServiceAudioSinkMuteToggleResponse{}
+func (x *HostServiceNotificationClosedResponse) Reset() {
+	*x = HostServiceNotificationClosedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1860,13 +1894,13 @@ func (x *HostServiceAudioSinkMuteToggleResponse) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSinkMuteToggleResponse) String() string {
+func (x *HostServiceNotificationClosedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSinkMuteToggleResponse) ProtoMessage() {}
+func (*HostServiceNotificationClosedResponse) ProtoMessage() {}
 
-func (x *HostServiceAudioSinkMuteToggleResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceNotificationClosedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1878,22 +1912,22 @@ func (x *HostServiceAudioSinkMuteToggleResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSinkMuteToggleResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSinkMuteToggleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceNotificationClosedResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceNotificationClosedResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{33}
 }
 
-type HostServiceAudioSourceVolumeAdjustRequest struct {
+type HostServiceNotificationActionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Direction v11.Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
+	Id        uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActionKey string `protobuf:"bytes,2,opt,name=action_key,json=actionKey,proto3" json:"action_key,omitempty"`
 }
 
-func (x *HostServiceAudioSourceVolumeAdjustRequest) Reset() {
-	*x = HostServiceAudioSourceVolumeAdjustRequest{}
+func (x *HostServiceNotificationActionRequest) Reset() {
+	*x = HostServiceNotificationActionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1901,13 +1935,13 @@ func (x *HostServiceAudioSourceVolumeAdjustRequest) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSourceVolumeAdjustRequest) String() string {
+func (x *HostServiceNotificationActionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSourceVolumeAdjustRequest) ProtoMessage() {}
+func (*HostServiceNotificationActionRequest) ProtoMessage() {}
 
-func (x *HostServiceAudioSourceVolumeAdjustRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceNotificationActionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1919,33 +1953,33 @@ func (x *HostServiceAudioSourceVolumeAdjustRequest) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSourceVolumeAdjustRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSourceVolumeAdjustRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceNotificationActionRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceNotificationActionRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *HostServiceAudioSourceVolumeAdjustRequest) GetId() string {
+func (x *HostServiceNotificationActionRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *HostServiceAudioSourceVolumeAdjustRequest) GetDirection() v11.Direction {
+func (x *HostServiceNotificationActionRequest) GetActionKey() string {
 	if x != nil {
-		return x.Direction
+		return x.ActionKey
 	}
-	return v11.Direction(0)
+	return ""
 }
 
-type HostServiceAudioSourceVolumeAdjustResponse struct {
+type HostServiceNotificationActionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceAudioSourceVolumeAdjustResponse) Reset() {
-	*x = HostServiceAudioSourceVolumeAdjustResponse{}
+func (x *HostServiceNotificationActionResponse) Reset() {
+	*x = HostServiceNotificationActionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1953,13 +1987,13 @@ func (x *HostServiceAudioSourceVolumeAdjustResponse) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSourceVolumeAdjustResponse) String() string {
+func (x *HostServiceNotificationActionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSourceVolumeAdjustResponse) ProtoMessage() {}
+func (*HostServiceNotificationActionResponse) ProtoMessage() {}
 
-func (x *HostServiceAudioSourceVolumeAdjustResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceNotificationActionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1971,21 +2005,22 @@ func (x *HostServiceAudioSourceVolumeAdjustResponse) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSourceVolumeAdjustResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSourceVolumeAdjustResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceNotificationActionResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceNotificationActionResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{35}
 }
 
-type HostServiceAudioSourceMuteToggleRequest struct {
+type HostServiceAudioSinkVolumeAdjustRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Direction v11.Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
 }
 
-func (x *HostServiceAudioSourceMuteToggleRequest) Reset() {
-	*x = HostServiceAudioSourceMuteToggleRequest{}
+func (x *HostServiceAudioSinkVolumeAdjustRequest) Reset() {
+	*x = HostServiceAudioSinkVolumeAdjustRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1993,13 +2028,13 @@ func (x *HostServiceAudioSourceMuteToggleRequest) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSourceMuteToggleRequest) String() string {
+func (x *HostServiceAudioSinkVolumeAdjustRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSourceMuteToggleRequest) ProtoMessage() {}
+func (*HostServiceAudioSinkVolumeAdjustRequest) ProtoMessage() {}
 
-func (x *HostServiceAudioSourceMuteToggleRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceAudioSinkVolumeAdjustRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2011,26 +2046,33 @@ func (x *HostServiceAudioSourceMuteToggleRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSourceMuteToggleRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSourceMuteToggleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceAudioSinkVolumeAdjustRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSinkVolumeAdjustRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *HostServiceAudioSourceMuteToggleRequest) GetId() string {
+func (x *HostServiceAudioSinkVolumeAdjustRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type HostServiceAudioSourceMuteToggleResponse struct {
+func (x *HostServiceAudioSinkVolumeAdjustRequest) GetDirection() v11.Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return v11.Direction(0)
+}
+
+type HostServiceAudioSinkVolumeAdjustResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceAudioSourceMuteToggleResponse) Reset() {
-	*x = HostServiceAudioSourceMuteToggleResponse{}
+func (x *HostServiceAudioSinkVolumeAdjustResponse) Reset() {
+	*x = HostServiceAudioSinkVolumeAdjustResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2038,13 +2080,13 @@ func (x *HostServiceAudioSourceMuteToggleResponse) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSourceMuteToggleResponse) String() string {
+func (x *HostServiceAudioSinkVolumeAdjustResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSourceMuteToggleResponse) ProtoMessage() {}
+func (*HostServiceAudioSinkVolumeAdjustResponse) ProtoMessage() {}
 
-func (x *HostServiceAudioSourceMuteToggleResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceAudioSinkVolumeAdjustResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2056,22 +2098,21 @@ func (x *HostServiceAudioSourceMuteToggleResponse) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSourceMuteToggleResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSourceMuteToggleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceAudioSinkVolumeAdjustResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSinkVolumeAdjustResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{37}
 }
 
-type HostServiceBrightnessAdjustRequest struct {
+type HostServiceAudioSinkMuteToggleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DevName   string        `protobuf:"bytes,1,opt,name=dev_name,json=devName,proto3" json:"dev_name,omitempty"`
-	Direction v11.Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *HostServiceBrightnessAdjustRequest) Reset() {
-	*x = HostServiceBrightnessAdjustRequest{}
+func (x *HostServiceAudioSinkMuteToggleRequest) Reset() {
+	*x = HostServiceAudioSinkMuteToggleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2079,13 +2120,13 @@ func (x *HostServiceBrightnessAdjustRequest) Reset() {
 	}
 }
 
-func (x *HostServiceBrightnessAdjustRequest) String() string {
+func (x *HostServiceAudioSinkMuteToggleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceBrightnessAdjustRequest) ProtoMessage() {}
+func (*HostServiceAudioSinkMuteToggleRequest) ProtoMessage() {}
 
-func (x *HostServiceBrightnessAdjustRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceAudioSinkMuteToggleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2097,48 +2138,587 @@ func (x *HostServiceBrightnessAdjustRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceBrightnessAdjustRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceBrightnessAdjustRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceAudioSinkMuteToggleRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSinkMuteToggleRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *HostServiceBrightnessAdjustRequest) GetDevName() string {
+func (x *HostServiceAudioSinkMuteToggleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type HostServiceAudioSinkMuteToggleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HostServiceAudioSinkMuteToggleResponse) Reset() {
+	*x = HostServiceAudioSinkMuteToggleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceAudioSinkMuteToggleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceAudioSinkMuteToggleResponse) ProtoMessage() {}
+
+func (x *HostServiceAudioSinkMuteToggleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceAudioSinkMuteToggleResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSinkMuteToggleResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{39}
+}
+
+type HostServiceAudioSourceVolumeAdjustRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Direction v11.Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
+}
+
+func (x *HostServiceAudioSourceVolumeAdjustRequest) Reset() {
+	*x = HostServiceAudioSourceVolumeAdjustRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceAudioSourceVolumeAdjustRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceAudioSourceVolumeAdjustRequest) ProtoMessage() {}
+
+func (x *HostServiceAudioSourceVolumeAdjustRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceAudioSourceVolumeAdjustRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSourceVolumeAdjustRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *HostServiceAudioSourceVolumeAdjustRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HostServiceAudioSourceVolumeAdjustRequest) GetDirection() v11.Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return v11.Direction(0)
+}
+
+type HostServiceAudioSourceVolumeAdjustResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HostServiceAudioSourceVolumeAdjustResponse) Reset() {
+	*x = HostServiceAudioSourceVolumeAdjustResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceAudioSourceVolumeAdjustResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceAudioSourceVolumeAdjustResponse) ProtoMessage() {}
+
+func (x *HostServiceAudioSourceVolumeAdjustResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceAudioSourceVolumeAdjustResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSourceVolumeAdjustResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{41}
+}
+
+type HostServiceAudioSourceMuteToggleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *HostServiceAudioSourceMuteToggleRequest) Reset() {
+	*x = HostServiceAudioSourceMuteToggleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceAudioSourceMuteToggleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceAudioSourceMuteToggleRequest) ProtoMessage() {}
+
+func (x *HostServiceAudioSourceMuteToggleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceAudioSourceMuteToggleRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSourceMuteToggleRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *HostServiceAudioSourceMuteToggleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type HostServiceAudioSourceMuteToggleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HostServiceAudioSourceMuteToggleResponse) Reset() {
+	*x = HostServiceAudioSourceMuteToggleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceAudioSourceMuteToggleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceAudioSourceMuteToggleResponse) ProtoMessage() {}
+
+func (x *HostServiceAudioSourceMuteToggleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceAudioSourceMuteToggleResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSourceMuteToggleResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{43}
+}
+
+type HostServiceBrightnessAdjustRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DevName   string        `protobuf:"bytes,1,opt,name=dev_name,json=devName,proto3" json:"dev_name,omitempty"`
+	Direction v11.Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
+}
+
+func (x *HostServiceBrightnessAdjustRequest) Reset() {
+	*x = HostServiceBrightnessAdjustRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceBrightnessAdjustRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceBrightnessAdjustRequest) ProtoMessage() {}
+
+func (x *HostServiceBrightnessAdjustRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceBrightnessAdjustRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceBrightnessAdjustRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *HostServiceBrightnessAdjustRequest) GetDevName() string {
+	if x != nil {
+		return x.DevName
+	}
+	return ""
+}
+
+func (x *HostServiceBrightnessAdjustRequest) GetDirection() v11.Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return v11.Direction(0)
+}
+
+type HostServiceBrightnessAdjustResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HostServiceBrightnessAdjustResponse) Reset() {
+	*x = HostServiceBrightnessAdjustResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceBrightnessAdjustResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceBrightnessAdjustResponse) ProtoMessage() {}
+
+func (x *HostServiceBrightnessAdjustResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceBrightnessAdjustResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceBrightnessAdjustResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{45}
+}
+
+type HostServiceCaptureFrameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address uint64 `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Width   int32  `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height  int32  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *HostServiceCaptureFrameRequest) Reset() {
+	*x = HostServiceCaptureFrameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceCaptureFrameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceCaptureFrameRequest) ProtoMessage() {}
+
+func (x *HostServiceCaptureFrameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceCaptureFrameRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceCaptureFrameRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *HostServiceCaptureFrameRequest) GetAddress() uint64 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *HostServiceCaptureFrameRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *HostServiceCaptureFrameRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type HostServiceCaptureFrameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image *ImageNRGBA `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *HostServiceCaptureFrameResponse) Reset() {
+	*x = HostServiceCaptureFrameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceCaptureFrameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceCaptureFrameResponse) ProtoMessage() {}
+
+func (x *HostServiceCaptureFrameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceCaptureFrameResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceCaptureFrameResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *HostServiceCaptureFrameResponse) GetImage() *ImageNRGBA {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type ControlServiceStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ControlServiceStatusRequest) Reset() {
+	*x = ControlServiceStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlServiceStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlServiceStatusRequest) ProtoMessage() {}
+
+func (x *ControlServiceStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlServiceStatusRequest.ProtoReflect.Descriptor instead.
+func (*ControlServiceStatusRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{48}
+}
+
+type ControlServiceStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version      string                                `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                                                      // host version.
+	Pid          int32                                 `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`                                                             // host process ID.
+	LogLevel     v1.LogLevel                           `protobuf:"varint,3,opt,name=log_level,json=logLevel,proto3,enum=hyprpanel.config.v1.LogLevel" json:"log_level,omitempty"` // active log level.
+	DbusEnabled  bool                                  `protobuf:"varint,4,opt,name=dbus_enabled,json=dbusEnabled,proto3" json:"dbus_enabled,omitempty"`                          // whether DBUS functionality is enabled.
+	AudioEnabled bool                                  `protobuf:"varint,5,opt,name=audio_enabled,json=audioEnabled,proto3" json:"audio_enabled,omitempty"`                       // whether audio functionality is enabled.
+	Panels       []*ControlServiceStatusResponse_Panel `protobuf:"bytes,6,rep,name=panels,proto3" json:"panels,omitempty"`                                                        // running and failed panels.
+}
+
+func (x *ControlServiceStatusResponse) Reset() {
+	*x = ControlServiceStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlServiceStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlServiceStatusResponse) ProtoMessage() {}
+
+func (x *ControlServiceStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlServiceStatusResponse.ProtoReflect.Descriptor instead.
+func (*ControlServiceStatusResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ControlServiceStatusResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ControlServiceStatusResponse) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ControlServiceStatusResponse) GetLogLevel() v1.LogLevel {
 	if x != nil {
-		return x.DevName
+		return x.LogLevel
 	}
-	return ""
+	return v1.LogLevel(0)
 }
 
-func (x *HostServiceBrightnessAdjustRequest) GetDirection() v11.Direction {
+func (x *ControlServiceStatusResponse) GetDbusEnabled() bool {
 	if x != nil {
-		return x.Direction
+		return x.DbusEnabled
 	}
-	return v11.Direction(0)
+	return false
 }
 
-type HostServiceBrightnessAdjustResponse struct {
+func (x *ControlServiceStatusResponse) GetAudioEnabled() bool {
+	if x != nil {
+		return x.AudioEnabled
+	}
+	return false
+}
+
+func (x *ControlServiceStatusResponse) GetPanels() []*ControlServiceStatusResponse_Panel {
+	if x != nil {
+		return x.Panels
+	}
+	return nil
+}
+
+type ControlServiceNotifyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Event *v11.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 }
 
-func (x *HostServiceBrightnessAdjustResponse) Reset() {
-	*x = HostServiceBrightnessAdjustResponse{}
+func (x *ControlServiceNotifyRequest) Reset() {
+	*x = ControlServiceNotifyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[39]
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HostServiceBrightnessAdjustResponse) String() string {
+func (x *ControlServiceNotifyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceBrightnessAdjustResponse) ProtoMessage() {}
+func (*ControlServiceNotifyRequest) ProtoMessage() {}
 
-func (x *HostServiceBrightnessAdjustResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[39]
+func (x *ControlServiceNotifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2149,38 +2729,41 @@ func (x *HostServiceBrightnessAdjustResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceBrightnessAdjustResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceBrightnessAdjustResponse) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ControlServiceNotifyRequest.ProtoReflect.Descriptor instead.
+func (*ControlServiceNotifyRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{50}
 }
 
-type HostServiceCaptureFrameRequest struct {
+func (x *ControlServiceNotifyRequest) GetEvent() *v11.Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type ControlServiceNotifyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Address uint64 `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
-	Width   int32  `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
-	Height  int32  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *HostServiceCaptureFrameRequest) Reset() {
-	*x = HostServiceCaptureFrameRequest{}
+func (x *ControlServiceNotifyResponse) Reset() {
+	*x = ControlServiceNotifyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[40]
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HostServiceCaptureFrameRequest) String() string {
+func (x *ControlServiceNotifyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceCaptureFrameRequest) ProtoMessage() {}
+func (*ControlServiceNotifyResponse) ProtoMessage() {}
 
-func (x *HostServiceCaptureFrameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[40]
+func (x *ControlServiceNotifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2191,57 +2774,127 @@ func (x *HostServiceCaptureFrameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceCaptureFrameRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceCaptureFrameRequest) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ControlServiceNotifyResponse.ProtoReflect.Descriptor instead.
+func (*ControlServiceNotifyResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *HostServiceCaptureFrameRequest) GetAddress() uint64 {
-	if x != nil {
-		return x.Address
+type ControlServicePanelSetVisibleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids     []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // panel identifiers to update, all panels are updated if empty.
+	Visible bool     `protobuf:"varint,2,opt,name=visible,proto3" json:"visible,omitempty"`
+}
+
+func (x *ControlServicePanelSetVisibleRequest) Reset() {
+	*x = ControlServicePanelSetVisibleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *HostServiceCaptureFrameRequest) GetWidth() int32 {
+func (x *ControlServicePanelSetVisibleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlServicePanelSetVisibleRequest) ProtoMessage() {}
+
+func (x *ControlServicePanelSetVisibleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlServicePanelSetVisibleRequest.ProtoReflect.Descriptor instead.
+func (*ControlServicePanelSetVisibleRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ControlServicePanelSetVisibleRequest) GetIds() []string {
 	if x != nil {
-		return x.Width
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-func (x *HostServiceCaptureFrameRequest) GetHeight() int32 {
+func (x *ControlServicePanelSetVisibleRequest) GetVisible() bool {
 	if x != nil {
-		return x.Height
+		return x.Visible
 	}
-	return 0
+	return false
 }
 
-type HostServiceCaptureFrameResponse struct {
+type ControlServicePanelSetVisibleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Image *ImageNRGBA `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+func (x *ControlServicePanelSetVisibleResponse) Reset() {
+	*x = ControlServicePanelSetVisibleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *HostServiceCaptureFrameResponse) Reset() {
-	*x = HostServiceCaptureFrameResponse{}
+func (x *ControlServicePanelSetVisibleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlServicePanelSetVisibleResponse) ProtoMessage() {}
+
+func (x *ControlServicePanelSetVisibleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlServicePanelSetVisibleResponse.ProtoReflect.Descriptor instead.
+func (*ControlServicePanelSetVisibleResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{53}
+}
+
+type ControlServiceReloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ControlServiceReloadRequest) Reset() {
+	*x = ControlServiceReloadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[41]
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HostServiceCaptureFrameResponse) String() string {
+func (x *ControlServiceReloadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceCaptureFrameResponse) ProtoMessage() {}
+func (*ControlServiceReloadRequest) ProtoMessage() {}
 
-func (x *HostServiceCaptureFrameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[41]
+func (x *ControlServiceReloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2252,16 +2905,47 @@ func (x *HostServiceCaptureFrameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceCaptureFrameResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceCaptureFrameResponse) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ControlServiceReloadRequest.ProtoReflect.Descriptor instead.
+func (*ControlServiceReloadRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *HostServiceCaptureFrameResponse) GetImage() *ImageNRGBA {
-	if x != nil {
-		return x.Image
+type ControlServiceReloadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ControlServiceReloadResponse) Reset() {
+	*x = ControlServiceReloadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
+}
+
+func (x *ControlServiceReloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlServiceReloadResponse) ProtoMessage() {}
+
+func (x *ControlServiceReloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlServiceReloadResponse.ProtoReflect.Descriptor instead.
+func (*ControlServiceReloadResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{55}
 }
 
 type AppInfo_Action struct {
@@ -2278,7 +2962,7 @@ type AppInfo_Action struct {
 func (x *AppInfo_Action) Reset() {
 	*x = AppInfo_Action{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[42]
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2291,7 +2975,7 @@ func (x *AppInfo_Action) String() string {
 func (*AppInfo_Action) ProtoMessage() {}
 
 func (x *AppInfo_Action) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[42]
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2335,6 +3019,125 @@ func (x *AppInfo_Action) GetRawExec() string {
 	return ""
 }
 
+type ControlServiceStatusResponse_Panel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                              // panel identifier.
+	Monitor       string  `protobuf:"bytes,2,opt,name=monitor,proto3" json:"monitor,omitempty"`                                    // configured monitor name.
+	Edge          v1.Edge `protobuf:"varint,3,opt,name=edge,proto3,enum=hyprpanel.config.v1.Edge" json:"edge,omitempty"`           // configured screen edge.
+	Visible       bool    `protobuf:"varint,4,opt,name=visible,proto3" json:"visible,omitempty"`                                   // whether the panel window is currently shown.
+	Restarts      uint32  `protobuf:"varint,5,opt,name=restarts,proto3" json:"restarts,omitempty"`                                 // number of times the panel has been restarted after a failure.
+	Failures      uint32  `protobuf:"varint,6,opt,name=failures,proto3" json:"failures,omitempty"`                                 // number of failures within the supervisor failure window.
+	LastError     string  `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`               // reason for the most recent failure.
+	Abandoned     bool    `protobuf:"varint,8,opt,name=abandoned,proto3" json:"abandoned,omitempty"`                               // whether the panel exceeded its failure budget and will not be restarted.
+	Running       bool    `protobuf:"varint,9,opt,name=running,proto3" json:"running,omitempty"`                                   // whether the panel plugin is currently running.
+	DroppedEvents uint64  `protobuf:"varint,10,opt,name=dropped_events,json=droppedEvents,proto3" json:"dropped_events,omitempty"` // number of events discarded because the panel was not consuming them fast enough.
+}
+
+func (x *ControlServiceStatusResponse_Panel) Reset() {
+	*x = ControlServiceStatusResponse_Panel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlServiceStatusResponse_Panel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlServiceStatusResponse_Panel) ProtoMessage() {}
+
+func (x *ControlServiceStatusResponse_Panel) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlServiceStatusResponse_Panel.ProtoReflect.Descriptor instead.
+func (*ControlServiceStatusResponse_Panel) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{49, 0}
+}
+
+func (x *ControlServiceStatusResponse_Panel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ControlServiceStatusResponse_Panel) GetMonitor() string {
+	if x != nil {
+		return x.Monitor
+	}
+	return ""
+}
+
+func (x *ControlServiceStatusResponse_Panel) GetEdge() v1.Edge {
+	if x != nil {
+		return x.Edge
+	}
+	return v1.Edge(0)
+}
+
+func (x *ControlServiceStatusResponse_Panel) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
+func (x *ControlServiceStatusResponse_Panel) GetRestarts() uint32 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *ControlServiceStatusResponse_Panel) GetFailures() uint32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *ControlServiceStatusResponse_Panel) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ControlServiceStatusResponse_Panel) GetAbandoned() bool {
+	if x != nil {
+		return x.Abandoned
+	}
+	return false
+}
+
+func (x *ControlServiceStatusResponse_Panel) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *ControlServiceStatusResponse_Panel) GetDroppedEvents() uint64 {
+	if x != nil {
+		return x.DroppedEvents
+	}
+	return 0
+}
+
 var File_hyprpanel_v1_hyprpanel_proto protoreflect.FileDescriptor
 
 var file_hyprpanel_v1_hyprpanel_proto_rawDesc = []byte{
@@ -2378,7 +3181,7 @@ var file_hyprpanel_v1_hyprpanel_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78,
 	0x65, 0x63, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x19,
 	0x0a, 0x08, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x72, 0x61, 0x77, 0x45, 0x78, 0x65, 0x63, 0x22, 0xcd, 0x01, 0x0a, 0x17, 0x50, 0x61,
+	0x52, 0x07, 0x72, 0x61, 0x77, 0x45, 0x78, 0x65, 0x63, 0x22, 0x99, 0x02, 0x0a, 0x17, 0x50, 0x61,
 	0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0d, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
@@ -2391,365 +3194,504 @@ var file_hyprpanel_v1_hyprpanel_proto_rawDesc = []byte{
 	0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65,
 	0x6c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x79,
 	0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73,
-	0x74, 0x79, 0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x50, 0x61, 0x6e,
-	0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x19, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x36, 0x0a, 0x24, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x25, 0x50, 0x61, 0x6e,
-	0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b,
-	0x0a, 0x19, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x16, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x21, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x74, 0x79, 0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x12, 0x4a, 0x0a, 0x0d, 0x68, 0x79, 0x70,
+	0x72, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x79, 0x70, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x68, 0x79, 0x70, 0x72, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x74, 0x0a, 0x18, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52,
+	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x60, 0x0a, 0x19, 0x50,
+	0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x31, 0x0a, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x44, 0x0a,
+	0x1a, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x36, 0x0a, 0x24, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x25, 0x50,
+	0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x1d, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22,
+	0x20, 0x0a, 0x1e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x5c, 0x0a, 0x1e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22,
+	0x21, 0x0a, 0x1f, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x40, 0x0a, 0x1e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x68, 0x65,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73,
+	0x68, 0x65, 0x65, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x50, 0x61, 0x6e, 0x65, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4e, 0x0a, 0x16, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45,
+	0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x19, 0x0a, 0x17, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45,
+	0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x21, 0x48,
+	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x56, 0x0a, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x22, 0x56, 0x0a, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x79, 0x70, 0x72,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x07, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x5a, 0x0a, 0x21, 0x48, 0x6f, 0x73,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08,
+	0x61, 0x70, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x5a,
+	0x0a, 0x21, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73,
+	0x74, 0x72, 0x61, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0c,
+	0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x24, 0x0a, 0x22, 0x48, 0x6f,
+	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x63, 0x0a, 0x2a, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41,
 	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
 	0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x24, 0x0a, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x2a, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61,
-	0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79,
-	0x22, 0x2d, 0x0a, 0x2b, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41,
+	0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x2d, 0x0a, 0x2b, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x61, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x1f, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x0b, 0x6f, 0x72, 0x69,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x4f, 0x72, 0x69, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x2c, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e,
+	0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78,
+	0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x2f,
+	0x0a, 0x2d, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73,
+	0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41,
 	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x9c, 0x01, 0x0a, 0x1f, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64,
-	0x65, 0x6c, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x67, 0x0a, 0x28, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79,
+	0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f,
+	0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
+	0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65,
+	0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x29, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65,
+	0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x68, 0x79, 0x70, 0x72,
 	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79,
-	0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22,
-	0x0a, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73,
-	0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x65, 0x0a, 0x2c, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a,
-	0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x2f, 0x0a, 0x2d, 0x48, 0x6f, 0x73,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d,
-	0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x28, 0x48, 0x6f,
+	0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x25, 0x0a, 0x23, 0x48, 0x6f,
 	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79,
-	0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65,
-	0x6d, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x29, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f,
-	0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xd2, 0x01, 0x0a, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
-	0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x25, 0x0a, 0x23, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x24,
-	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x25, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a,
-	0x24, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4b, 0x65, 0x79, 0x22, 0x27, 0x0a, 0x25, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a,
-	0x27, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69,
-	0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79,
-	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x28, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x37, 0x0a, 0x25, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x26, 0x48, 0x6f,
+	0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x76, 0x0a, 0x24, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x25, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x55, 0x0a, 0x24, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x27, 0x0a, 0x25, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x76, 0x0a, 0x27, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x28, 0x48, 0x6f,
 	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69,
-	0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x29, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c,
-	0x0a, 0x2a, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64,
-	0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64,
-	0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x27,
+	0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x25, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75,
+	0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x28, 0x0a, 0x26, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75,
+	0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x29, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x2a, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x39, 0x0a, 0x27, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2a, 0x0a, 0x28,
 	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f,
 	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2a, 0x0a, 0x28, 0x48, 0x6f, 0x73, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x76,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x76,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x25, 0x0a, 0x23, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x1e, 0x48, 0x6f, 0x73, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x22, 0x51, 0x0a, 0x1f, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x52, 0x47, 0x42, 0x41, 0x52, 0x05,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x2a, 0x9a, 0x01, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61,
-	0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x26, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59, 0x5f, 0x53, 0x43,
-	0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27,
-	0x0a, 0x23, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59, 0x5f, 0x53, 0x43, 0x52, 0x4f, 0x4c, 0x4c,
-	0x5f, 0x4f, 0x52, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x45, 0x52,
-	0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x53, 0x59, 0x53, 0x54, 0x52,
-	0x41, 0x59, 0x5f, 0x53, 0x43, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x49, 0x45, 0x4e, 0x54,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x4f, 0x4e, 0x54, 0x41, 0x4c,
-	0x10, 0x02, 0x2a, 0x76, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e,
-	0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41,
-	0x59, 0x5f, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x59,
-	0x53, 0x54, 0x52, 0x41, 0x59, 0x5f, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x43, 0x4c, 0x49, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x59,
-	0x53, 0x54, 0x52, 0x41, 0x59, 0x5f, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x48, 0x4f, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xbf, 0x01, 0x0a, 0x18, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x26, 0x4e, 0x4f, 0x54, 0x49, 0x46,
-	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x52,
-	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
-	0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x4e,
-	0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
-	0x45, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x4d, 0x49, 0x53,
-	0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x22, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x64, 0x65, 0x76, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x64, 0x65, 0x76, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x0a, 0x23, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a,
+	0x1e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x51, 0x0a, 0x1f, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x52,
+	0x47, 0x42, 0x41, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcb, 0x04, 0x0a, 0x1c, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x62, 0x75, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x62, 0x75, 0x73, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x06, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x52, 0x06, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x73, 0x1a, 0xb0, 0x02, 0x0a, 0x05, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x04, 0x65, 0x64, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x67,
+	0x65, 0x52, 0x04, 0x65, 0x64, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x62, 0x61, 0x6e,
+	0x64, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x62, 0x61,
+	0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x0a, 0x24, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65,
+	0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x27, 0x0a, 0x25, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x61, 0x6e,
+	0x65, 0x6c, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2a, 0x9a, 0x01, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53,
+	0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2a, 0x0a, 0x26, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59, 0x5f, 0x53, 0x43, 0x52, 0x4f,
+	0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23,
+	0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59, 0x5f, 0x53, 0x43, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x4f,
+	0x52, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x45, 0x52, 0x54, 0x49,
+	0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59,
+	0x5f, 0x53, 0x43, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x4f, 0x4e, 0x54, 0x41, 0x4c, 0x10, 0x02,
+	0x2a, 0x76, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59, 0x5f,
+	0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x59, 0x53, 0x54,
+	0x52, 0x41, 0x59, 0x5f, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x4c, 0x49, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x59, 0x53, 0x54,
+	0x52, 0x41, 0x59, 0x5f, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48,
+	0x4f, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xbf, 0x01, 0x0a, 0x18, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x26, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43,
 	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x52, 0x45, 0x41,
-	0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x32, 0x9c, 0x02, 0x0a,
-	0x0c, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a,
-	0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68,
+	0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x4e, 0x4f, 0x54,
+	0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x4d, 0x49, 0x53, 0x53, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x32, 0xe1, 0x04, 0x0a, 0x0c, 0x50,
+	0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x04, 0x49,
+	0x6e, 0x69, 0x74, 0x12, 0x25, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x68,
 	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65,
-	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x27,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x67, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c,
+	0x65, 0x12, 0x2b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
 	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x79,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
 	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x58, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x68, 0x79, 0x70,
-	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf5, 0x0f, 0x0a, 0x0b,
-	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x04, 0x45,
-	0x78, 0x65, 0x63, 0x12, 0x24, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78,
-	0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x79, 0x70, 0x72,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x74, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69,
-	0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46,
-	0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61,
-	0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x2e, 0x68, 0x79, 0x70, 0x72,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x68, 0x79, 0x70,
-	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a,
-	0x18, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72,
-	0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x38, 0x2e, 0x68, 0x79, 0x70, 0x72,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x61, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79,
-	0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e,
-	0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x12,
-	0x2d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61,
-	0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
-	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79,
-	0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x95,
-	0x01, 0x0a, 0x1a, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x2e,
+	0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x26, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e,
+	0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf5,
+	0x0f, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53,
+	0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x24, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0f, 0x53, 0x79, 0x73,
+	0x74, 0x72, 0x61, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
 	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d,
-	0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x68, 0x79, 0x70, 0x72,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x74, 0x72,
-	0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f,
-	0x77, 0x12, 0x36, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x8f, 0x01, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x61, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x38, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72,
+	0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6e, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f,
+	0x6c, 0x6c, 0x12, 0x2d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73,
+	0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
 	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74,
-	0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f, 0x53, 0x68,
-	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x95, 0x01, 0x0a, 0x1a, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e,
+	0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x12, 0x3a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72,
+	0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65,
+	0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x16, 0x53, 0x79,
+	0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f,
+	0x53, 0x68, 0x6f, 0x77, 0x12, 0x36, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54,
+	0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65,
+	0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79,
+	0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x68, 0x79, 0x70, 0x72,
 	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41,
-	0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e,
-	0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
-	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x12, 0x4e,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e,
+	0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d,
+	0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x12, 0x32, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e,
 	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x64, 0x12, 0x32, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x12, 0x4e, 0x6f,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
+	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a,
+	0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f,
 	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x32, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x41, 0x75,
-	0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a,
-	0x75, 0x73, 0x74, 0x12, 0x35, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75,
-	0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a,
-	0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x68, 0x79, 0x70,
-	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b,
-	0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x33, 0x2e, 0x68, 0x79, 0x70,
-	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75,
-	0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x34, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53,
-	0x69, 0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x17, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73,
-	0x74, 0x12, 0x37, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a,
+	0x15, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x12, 0x35, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e,
+	0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53,
+	0x69, 0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x33, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e,
+	0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x17, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x12, 0x37, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x12, 0x35, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
 	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69,
-	0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a,
-	0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x68, 0x79, 0x70,
-	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x35,
-	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54,
-	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a,
-	0x10, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73,
-	0x74, 0x12, 0x30, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x72, 0x69, 0x67,
-	0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x72,
-	0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72,
-	0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
+	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75,
+	0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x77, 0x0a, 0x10, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x12, 0x30, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42,
+	0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
 	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43,
-	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0xac, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x79, 0x70, 0x72,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x64, 0x66, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70,
-	0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70,
-	0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x48, 0x79, 0x70, 0x72,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x48, 0x79, 0x70, 0x72, 0x70,
-	0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x0d, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0c, 0x43, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xaf, 0x03, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x29, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x06, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x12, 0x29, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x0f, 0x50,
+	0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x32,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x61, 0x6e, 0x65,
+	0x6c, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x06, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x29, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xac, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x48,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x64, 0x66, 0x2f,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x58, 0x58, 0xaa, 0x02,
+	0x0c, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c,
+	0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x48,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2765,7 +3707,7 @@ func file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP() []byte {
 }
 
 var file_hyprpanel_v1_hyprpanel_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_hyprpanel_v1_hyprpanel_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_hyprpanel_v1_hyprpanel_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_hyprpanel_v1_hyprpanel_proto_goTypes = []interface{}{
 	(SystrayScrollOrientation)(0),                         // 0: hyprpanel.v1.SystrayScrollOrientation
 	(SystrayMenuEvent)(0),                                 // 1: hyprpanel.v1.SystrayMenuEvent
@@ -2774,109 +3716,148 @@ var file_hyprpanel_v1_hyprpanel_proto_goTypes = []interface{}{
 	(*AppInfo)(nil),                                       // 4: hyprpanel.v1.AppInfo
 	(*PanelServiceInitRequest)(nil),                       // 5: hyprpanel.v1.PanelServiceInitRequest
 	(*PanelServiceInitResponse)(nil),                      // 6: hyprpanel.v1.PanelServiceInitResponse
-	(*PanelServiceNotifyRequest)(nil),                     // 7: hyprpanel.v1.PanelServiceNotifyRequest
-	(*PanelServiceNotifyResponse)(nil),                    // 8: hyprpanel.v1.PanelServiceNotifyResponse
+	(*PanelServiceEventsRequest)(nil),                     // 7: hyprpanel.v1.PanelServiceEventsRequest
+	(*PanelServiceEventsResponse)(nil),                    // 8: hyprpanel.v1.PanelServiceEventsResponse
 	(*PanelServiceNotificationCloseRequest)(nil),          // 9: hyprpanel.v1.PanelServiceNotificationCloseRequest
 	(*PanelServiceNotificationCloseResponse)(nil),         // 10: hyprpanel.v1.PanelServiceNotificationCloseResponse
-	(*PanelServiceCloseRequest)(nil),                      // 11: hyprpanel.v1.PanelServiceCloseRequest
-	(*PanelServiceCloseResponse)(nil),                     // 12: hyprpanel.v1.PanelServiceCloseResponse
-	(*HostServiceExecRequest)(nil),                        // 13: hyprpanel.v1.HostServiceExecRequest
-	(*HostServiceExecResponse)(nil),                       // 14: hyprpanel.v1.HostServiceExecResponse
-	(*HostServiceFindApplicationRequest)(nil),             // 15: hyprpanel.v1.HostServiceFindApplicationRequest
-	(*HostServiceFindApplicationResponse)(nil),            // 16: hyprpanel.v1.HostServiceFindApplicationResponse
-	(*HostServiceSystrayActivateRequest)(nil),             // 17: hyprpanel.v1.HostServiceSystrayActivateRequest
-	(*HostServiceSystrayActivateResponse)(nil),            // 18: hyprpanel.v1.HostServiceSystrayActivateResponse
-	(*HostServiceSystraySecondaryActivateRequest)(nil),    // 19: hyprpanel.v1.HostServiceSystraySecondaryActivateRequest
-	(*HostServiceSystraySecondaryActivateResponse)(nil),   // 20: hyprpanel.v1.HostServiceSystraySecondaryActivateResponse
-	(*HostServiceSystrayScrollRequest)(nil),               // 21: hyprpanel.v1.HostServiceSystrayScrollRequest
-	(*HostServiceSystrayScrollResponse)(nil),              // 22: hyprpanel.v1.HostServiceSystrayScrollResponse
-	(*HostServiceSystrayMenuContextActivateRequest)(nil),  // 23: hyprpanel.v1.HostServiceSystrayMenuContextActivateRequest
-	(*HostServiceSystrayMenuContextActivateResponse)(nil), // 24: hyprpanel.v1.HostServiceSystrayMenuContextActivateResponse
-	(*HostServiceSystrayMenuAboutToShowRequest)(nil),      // 25: hyprpanel.v1.HostServiceSystrayMenuAboutToShowRequest
-	(*HostServiceSystrayMenuAboutToShowResponse)(nil),     // 26: hyprpanel.v1.HostServiceSystrayMenuAboutToShowResponse
-	(*HostServiceSystrayMenuEventRequest)(nil),            // 27: hyprpanel.v1.HostServiceSystrayMenuEventRequest
-	(*HostServiceSystrayMenuEventResponse)(nil),           // 28: hyprpanel.v1.HostServiceSystrayMenuEventResponse
-	(*HostServiceNotificationClosedRequest)(nil),          // 29: hyprpanel.v1.HostServiceNotificationClosedRequest
-	(*HostServiceNotificationClosedResponse)(nil),         // 30: hyprpanel.v1.HostServiceNotificationClosedResponse
-	(*HostServiceNotificationActionRequest)(nil),          // 31: hyprpanel.v1.HostServiceNotificationActionRequest
-	(*HostServiceNotificationActionResponse)(nil),         // 32: hyprpanel.v1.HostServiceNotificationActionResponse
-	(*HostServiceAudioSinkVolumeAdjustRequest)(nil),       // 33: hyprpanel.v1.HostServiceAudioSinkVolumeAdjustRequest
-	(*HostServiceAudioSinkVolumeAdjustResponse)(nil),      // 34: hyprpanel.v1.HostServiceAudioSinkVolumeAdjustResponse
-	(*HostServiceAudioSinkMuteToggleRequest)(nil),         // 35: hyprpanel.v1.HostServiceAudioSinkMuteToggleRequest
-	(*HostServiceAudioSinkMuteToggleResponse)(nil),        // 36: hyprpanel.v1.HostServiceAudioSinkMuteToggleResponse
-	(*HostServiceAudioSourceVolumeAdjustRequest)(nil),     // 37: hyprpanel.v1.HostServiceAudioSourceVolumeAdjustRequest
-	(*HostServiceAudioSourceVolumeAdjustResponse)(nil),    // 38: hyprpanel.v1.HostServiceAudioSourceVolumeAdjustResponse
-	(*HostServiceAudioSourceMuteToggleRequest)(nil),       // 39: hyprpanel.v1.HostServiceAudioSourceMuteToggleRequest
-	(*HostServiceAudioSourceMuteToggleResponse)(nil),      // 40: hyprpanel.v1.HostServiceAudioSourceMuteToggleResponse
-	(*HostServiceBrightnessAdjustRequest)(nil),            // 41: hyprpanel.v1.HostServiceBrightnessAdjustRequest
-	(*HostServiceBrightnessAdjustResponse)(nil),           // 42: hyprpanel.v1.HostServiceBrightnessAdjustResponse
-	(*HostServiceCaptureFrameRequest)(nil),                // 43: hyprpanel.v1.HostServiceCaptureFrameRequest
-	(*HostServiceCaptureFrameResponse)(nil),               // 44: hyprpanel.v1.HostServiceCaptureFrameResponse
-	(*AppInfo_Action)(nil),                                // 45: hyprpanel.v1.AppInfo.Action
-	(v1.LogLevel)(0),                                      // 46: hyprpanel.config.v1.LogLevel
-	(*v1.Panel)(nil),                                      // 47: hyprpanel.config.v1.Panel
-	(*v11.Event)(nil),                                     // 48: hyprpanel.event.v1.Event
-	(*anypb.Any)(nil),                                     // 49: google.protobuf.Any
-	(v11.Direction)(0),                                    // 50: hyprpanel.event.v1.Direction
+	(*PanelServiceSetVisibleRequest)(nil),                 // 11: hyprpanel.v1.PanelServiceSetVisibleRequest
+	(*PanelServiceSetVisibleResponse)(nil),                // 12: hyprpanel.v1.PanelServiceSetVisibleResponse
+	(*PanelServiceSetLogLevelRequest)(nil),                // 13: hyprpanel.v1.PanelServiceSetLogLevelRequest
+	(*PanelServiceSetLogLevelResponse)(nil),               // 14: hyprpanel.v1.PanelServiceSetLogLevelResponse
+	(*PanelServiceUpdateStyleRequest)(nil),                // 15: hyprpanel.v1.PanelServiceUpdateStyleRequest
+	(*PanelServiceUpdateStyleResponse)(nil),               // 16: hyprpanel.v1.PanelServiceUpdateStyleResponse
+	(*PanelServiceCloseRequest)(nil),                      // 17: hyprpanel.v1.PanelServiceCloseRequest
+	(*PanelServiceCloseResponse)(nil),                     // 18: hyprpanel.v1.PanelServiceCloseResponse
+	(*HostServiceExecRequest)(nil),                        // 19: hyprpanel.v1.HostServiceExecRequest
+	(*HostServiceExecResponse)(nil),                       // 20: hyprpanel.v1.HostServiceExecResponse
+	(*HostServiceFindApplicationRequest)(nil),             // 21: hyprpanel.v1.HostServiceFindApplicationRequest
+	(*HostServiceFindApplicationResponse)(nil),            // 22: hyprpanel.v1.HostServiceFindApplicationResponse
+	(*HostServiceSystrayActivateRequest)(nil),             // 23: hyprpanel.v1.HostServiceSystrayActivateRequest
+	(*HostServiceSystrayActivateResponse)(nil),            // 24: hyprpanel.v1.HostServiceSystrayActivateResponse
+	(*HostServiceSystraySecondaryActivateRequest)(nil),    // 25: hyprpanel.v1.HostServiceSystraySecondaryActivateRequest
+	(*HostServiceSystraySecondaryActivateResponse)(nil),   // 26: hyprpanel.v1.HostServiceSystraySecondaryActivateResponse
+	(*HostServiceSystrayScrollRequest)(nil),               // 27: hyprpanel.v1.HostServiceSystrayScrollRequest
+	(*HostServiceSystrayScrollResponse)(nil),              // 28: hyprpanel.v1.HostServiceSystrayScrollResponse
+	(*HostServiceSystrayMenuContextActivateRequest)(nil),  // 29: hyprpanel.v1.HostServiceSystrayMenuContextActivateRequest
+	(*HostServiceSystrayMenuContextActivateResponse)(nil), // 30: hyprpanel.v1.HostServiceSystrayMenuContextActivateResponse
+	(*HostServiceSystrayMenuAboutToShowRequest)(nil),      // 31: hyprpanel.v1.HostServiceSystrayMenuAboutToShowRequest
+	(*HostServiceSystrayMenuAboutToShowResponse)(nil),     // 32: hyprpanel.v1.HostServiceSystrayMenuAboutToShowResponse
+	(*HostServiceSystrayMenuEventRequest)(nil),            // 33: hyprpanel.v1.HostServiceSystrayMenuEventRequest
+	(*HostServiceSystrayMenuEventResponse)(nil),           // 34: hyprpanel.v1.HostServiceSystrayMenuEventResponse
+	(*HostServiceNotificationClosedRequest)(nil),          // 35: hyprpanel.v1.HostServiceNotificationClosedRequest
+	(*HostServiceNotificationClosedResponse)(nil),         // 36: hyprpanel.v1.HostServiceNotificationClosedResponse
+	(*HostServiceNotificationActionRequest)(nil),          // 37: hyprpanel.v1.HostServiceNotificationActionRequest
+	(*HostServiceNotificationActionResponse)(nil),         // 38: hyprpanel.v1.HostServiceNotificationActionResponse
+	(*HostServiceAudioSinkVolumeAdjustRequest)(nil),       // 39: hyprpanel.v1.HostServiceAudioSinkVolumeAdjustRequest
+	(*HostServiceAudioSinkVolumeAdjustResponse)(nil),      // 40: hyprpanel.v1.HostServiceAudioSinkVolumeAdjustResponse
+	(*HostServiceAudioSinkMuteToggleRequest)(nil),         // 41: hyprpanel.v1.HostServiceAudioSinkMuteToggleRequest
+	(*HostServiceAudioSinkMuteToggleResponse)(nil),        // 42: hyprpanel.v1.HostServiceAudioSinkMuteToggleResponse
+	(*HostServiceAudioSourceVolumeAdjustRequest)(nil),     // 43: hyprpanel.v1.HostServiceAudioSourceVolumeAdjustRequest
+	(*HostServiceAudioSourceVolumeAdjustResponse)(nil),    // 44: hyprpanel.v1.HostServiceAudioSourceVolumeAdjustResponse
+	(*HostServiceAudioSourceMuteToggleRequest)(nil),       // 45: hyprpanel.v1.HostServiceAudioSourceMuteToggleRequest
+	(*HostServiceAudioSourceMuteToggleResponse)(nil),      // 46: hyprpanel.v1.HostServiceAudioSourceMuteToggleResponse
+	(*HostServiceBrightnessAdjustRequest)(nil),            // 47: hyprpanel.v1.HostServiceBrightnessAdjustRequest
+	(*HostServiceBrightnessAdjustResponse)(nil),           // 48: hyprpanel.v1.HostServiceBrightnessAdjustResponse
+	(*HostServiceCaptureFrameRequest)(nil),                // 49: hyprpanel.v1.HostServiceCaptureFrameRequest
+	(*HostServiceCaptureFrameResponse)(nil),               // 50: hyprpanel.v1.HostServiceCaptureFrameResponse
+	(*ControlServiceStatusRequest)(nil),                   // 51: hyprpanel.v1.ControlServiceStatusRequest
+	(*ControlServiceStatusResponse)(nil),                  // 52: hyprpanel.v1.ControlServiceStatusResponse
+	(*ControlServiceNotifyRequest)(nil),                   // 53: hyprpanel.v1.ControlServiceNotifyRequest
+	(*ControlServiceNotifyResponse)(nil),                  // 54: hyprpanel.v1.ControlServiceNotifyResponse
+	(*ControlServicePanelSetVisibleRequest)(nil),          // 55: hyprpanel.v1.ControlServicePanelSetVisibleRequest
+	(*ControlServicePanelSetVisibleResponse)(nil),         // 56: hyprpanel.v1.ControlServicePanelSetVisibleResponse
+	(*ControlServiceReloadRequest)(nil),                   // 57: hyprpanel.v1.ControlServiceReloadRequest
+	(*ControlServiceReloadResponse)(nil),                  // 58: hyprpanel.v1.ControlServiceReloadResponse
+	(*AppInfo_Action)(nil),                                // 59: hyprpanel.v1.AppInfo.Action
+	(*ControlServiceStatusResponse_Panel)(nil),            // 60: hyprpanel.v1.ControlServiceStatusResponse.Panel
+	(v1.LogLevel)(0),                                      // 61: hyprpanel.config.v1.LogLevel
+	(*v1.Panel)(nil),                                      // 62: hyprpanel.config.v1.Panel
+	(*v11.HyprSnapshotValue)(nil),                         // 63: hyprpanel.event.v1.HyprSnapshotValue
+	(v11.EventKind)(0),                                    // 64: hyprpanel.event.v1.EventKind
+	(*v11.Event)(nil),                                     // 65: hyprpanel.event.v1.Event
+	(*anypb.Any)(nil),                                     // 66: google.protobuf.Any
+	(v11.Direction)(0),                                    // 67: hyprpanel.event.v1.Direction
+	(v1.Edge)(0),                                          // 68: hyprpanel.config.v1.Edge
 }
 var file_hyprpanel_v1_hyprpanel_proto_depIdxs = []int32{
-	45, // 0: hyprpanel.v1.AppInfo.actions:type_name -> hyprpanel.v1.AppInfo.Action
-	46, // 1: hyprpanel.v1.PanelServiceInitRequest.log_level:type_name -> hyprpanel.config.v1.LogLevel
-	47, // 2: hyprpanel.v1.PanelServiceInitRequest.config:type_name -> hyprpanel.config.v1.Panel
-	48, // 3: hyprpanel.v1.PanelServiceNotifyRequest.event:type_name -> hyprpanel.event.v1.Event
-	45, // 4: hyprpanel.v1.HostServiceExecRequest.action:type_name -> hyprpanel.v1.AppInfo.Action
-	4,  // 5: hyprpanel.v1.HostServiceFindApplicationResponse.app_info:type_name -> hyprpanel.v1.AppInfo
-	0,  // 6: hyprpanel.v1.HostServiceSystrayScrollRequest.orientation:type_name -> hyprpanel.v1.SystrayScrollOrientation
-	1,  // 7: hyprpanel.v1.HostServiceSystrayMenuEventRequest.event_id:type_name -> hyprpanel.v1.SystrayMenuEvent
-	49, // 8: hyprpanel.v1.HostServiceSystrayMenuEventRequest.data:type_name -> google.protobuf.Any
-	2,  // 9: hyprpanel.v1.HostServiceNotificationClosedRequest.reason:type_name -> hyprpanel.v1.NotificationClosedReason
-	50, // 10: hyprpanel.v1.HostServiceAudioSinkVolumeAdjustRequest.direction:type_name -> hyprpanel.event.v1.Direction
-	50, // 11: hyprpanel.v1.HostServiceAudioSourceVolumeAdjustRequest.direction:type_name -> hyprpanel.event.v1.Direction
-	50, // 12: hyprpanel.v1.HostServiceBrightnessAdjustRequest.direction:type_name -> hyprpanel.event.v1.Direction
-	3,  // 13: hyprpanel.v1.HostServiceCaptureFrameResponse.image:type_name -> hyprpanel.v1.ImageNRGBA
-	5,  // 14: hyprpanel.v1.PanelService.Init:input_type -> hyprpanel.v1.PanelServiceInitRequest
-	7,  // 15: hyprpanel.v1.PanelService.Notify:input_type -> hyprpanel.v1.PanelServiceNotifyRequest
-	11, // 16: hyprpanel.v1.PanelService.Close:input_type -> hyprpanel.v1.PanelServiceCloseRequest
-	13, // 17: hyprpanel.v1.HostService.Exec:input_type -> hyprpanel.v1.HostServiceExecRequest
-	15, // 18: hyprpanel.v1.HostService.FindApplication:input_type -> hyprpanel.v1.HostServiceFindApplicationRequest
-	17, // 19: hyprpanel.v1.HostService.SystrayActivate:input_type -> hyprpanel.v1.HostServiceSystrayActivateRequest
-	19, // 20: hyprpanel.v1.HostService.SystraySecondaryActivate:input_type -> hyprpanel.v1.HostServiceSystraySecondaryActivateRequest
-	21, // 21: hyprpanel.v1.HostService.SystrayScroll:input_type -> hyprpanel.v1.HostServiceSystrayScrollRequest
-	23, // 22: hyprpanel.v1.HostService.SystrayMenuContextActivate:input_type -> hyprpanel.v1.HostServiceSystrayMenuContextActivateRequest
-	25, // 23: hyprpanel.v1.HostService.SystrayMenuAboutToShow:input_type -> hyprpanel.v1.HostServiceSystrayMenuAboutToShowRequest
-	27, // 24: hyprpanel.v1.HostService.SystrayMenuEvent:input_type -> hyprpanel.v1.HostServiceSystrayMenuEventRequest
-	29, // 25: hyprpanel.v1.HostService.NotificationClosed:input_type -> hyprpanel.v1.HostServiceNotificationClosedRequest
-	31, // 26: hyprpanel.v1.HostService.NotificationAction:input_type -> hyprpanel.v1.HostServiceNotificationActionRequest
-	33, // 27: hyprpanel.v1.HostService.AudioSinkVolumeAdjust:input_type -> hyprpanel.v1.HostServiceAudioSinkVolumeAdjustRequest
-	35, // 28: hyprpanel.v1.HostService.AudioSinkMuteToggle:input_type -> hyprpanel.v1.HostServiceAudioSinkMuteToggleRequest
-	37, // 29: hyprpanel.v1.HostService.AudioSourceVolumeAdjust:input_type -> hyprpanel.v1.HostServiceAudioSourceVolumeAdjustRequest
-	39, // 30: hyprpanel.v1.HostService.AudioSourceMuteToggle:input_type -> hyprpanel.v1.HostServiceAudioSourceMuteToggleRequest
-	41, // 31: hyprpanel.v1.HostService.BrightnessAdjust:input_type -> hyprpanel.v1.HostServiceBrightnessAdjustRequest
-	43, // 32: hyprpanel.v1.HostService.CaptureFrame:input_type -> hyprpanel.v1.HostServiceCaptureFrameRequest
-	6,  // 33: hyprpanel.v1.PanelService.Init:output_type -> hyprpanel.v1.PanelServiceInitResponse
-	8,  // 34: hyprpanel.v1.PanelService.Notify:output_type -> hyprpanel.v1.PanelServiceNotifyResponse
-	12, // 35: hyprpanel.v1.PanelService.Close:output_type -> hyprpanel.v1.PanelServiceCloseResponse
-	14, // 36: hyprpanel.v1.HostService.Exec:output_type -> hyprpanel.v1.HostServiceExecResponse
-	16, // 37: hyprpanel.v1.HostService.FindApplication:output_type -> hyprpanel.v1.HostServiceFindApplicationResponse
-	18, // 38: hyprpanel.v1.HostService.SystrayActivate:output_type -> hyprpanel.v1.HostServiceSystrayActivateResponse
-	20, // 39: hyprpanel.v1.HostService.SystraySecondaryActivate:output_type -> hyprpanel.v1.HostServiceSystraySecondaryActivateResponse
-	22, // 40: hyprpanel.v1.HostService.SystrayScroll:output_type -> hyprpanel.v1.HostServiceSystrayScrollResponse
-	24, // 41: hyprpanel.v1.HostService.SystrayMenuContextActivate:output_type -> hyprpanel.v1.HostServiceSystrayMenuContextActivateResponse
-	26, // 42: hyprpanel.v1.HostService.SystrayMenuAboutToShow:output_type -> hyprpanel.v1.HostServiceSystrayMenuAboutToShowResponse
-	28, // 43: hyprpanel.v1.HostService.SystrayMenuEvent:output_type -> hyprpanel.v1.HostServiceSystrayMenuEventResponse
-	30, // 44: hyprpanel.v1.HostService.NotificationClosed:output_type -> hyprpanel.v1.HostServiceNotificationClosedResponse
-	32, // 45: hyprpanel.v1.HostService.NotificationAction:output_type -> hyprpanel.v1.HostServiceNotificationActionResponse
-	34, // 46: hyprpanel.v1.HostService.AudioSinkVolumeAdjust:output_type -> hyprpanel.v1.HostServiceAudioSinkVolumeAdjustResponse
-	36, // 47: hyprpanel.v1.HostService.AudioSinkMuteToggle:output_type -> hyprpanel.v1.HostServiceAudioSinkMuteToggleResponse
-	38, // 48: hyprpanel.v1.HostService.AudioSourceVolumeAdjust:output_type -> hyprpanel.v1.HostServiceAudioSourceVolumeAdjustResponse
-	40, // 49: hyprpanel.v1.HostService.AudioSourceMuteToggle:output_type -> hyprpanel.v1.HostServiceAudioSourceMuteToggleResponse
-	42, // 50: hyprpanel.v1.HostService.BrightnessAdjust:output_type -> hyprpanel.v1.HostServiceBrightnessAdjustResponse
-	44, // 51: hyprpanel.v1.HostService.CaptureFrame:output_type -> hyprpanel.v1.HostServiceCaptureFrameResponse
-	33, // [33:52] is the sub-list for method output_type
-	14, // [14:33] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	59, // 0: hyprpanel.v1.AppInfo.actions:type_name -> hyprpanel.v1.AppInfo.Action
+	61, // 1: hyprpanel.v1.PanelServiceInitRequest.log_level:type_name -> hyprpanel.config.v1.LogLevel
+	62, // 2: hyprpanel.v1.PanelServiceInitRequest.config:type_name -> hyprpanel.config.v1.Panel
+	63, // 3: hyprpanel.v1.PanelServiceInitRequest.hypr_snapshot:type_name -> hyprpanel.event.v1.HyprSnapshotValue
+	64, // 4: hyprpanel.v1.PanelServiceInitResponse.kinds:type_name -> hyprpanel.event.v1.EventKind
+	65, // 5: hyprpanel.v1.PanelServiceEventsRequest.events:type_name -> hyprpanel.event.v1.Event
+	61, // 6: hyprpanel.v1.PanelServiceSetLogLevelRequest.log_level:type_name -> hyprpanel.config.v1.LogLevel
+	59, // 7: hyprpanel.v1.HostServiceExecRequest.action:type_name -> hyprpanel.v1.AppInfo.Action
+	4,  // 8: hyprpanel.v1.HostServiceFindApplicationResponse.app_info:type_name -> hyprpanel.v1.AppInfo
+	0,  // 9: hyprpanel.v1.HostServiceSystrayScrollRequest.orientation:type_name -> hyprpanel.v1.SystrayScrollOrientation
+	1,  // 10: hyprpanel.v1.HostServiceSystrayMenuEventRequest.event_id:type_name -> hyprpanel.v1.SystrayMenuEvent
+	66, // 11: hyprpanel.v1.HostServiceSystrayMenuEventRequest.data:type_name -> google.protobuf.Any
+	2,  // 12: hyprpanel.v1.HostServiceNotificationClosedRequest.reason:type_name -> hyprpanel.v1.NotificationClosedReason
+	67, // 13: hyprpanel.v1.HostServiceAudioSinkVolumeAdjustRequest.direction:type_name -> hyprpanel.event.v1.Direction
+	67, // 14: hyprpanel.v1.HostServiceAudioSourceVolumeAdjustRequest.direction:type_name -> hyprpanel.event.v1.Direction
+	67, // 15: hyprpanel.v1.HostServiceBrightnessAdjustRequest.direction:type_name -> hyprpanel.event.v1.Direction
+	3,  // 16: hyprpanel.v1.HostServiceCaptureFrameResponse.image:type_name -> hyprpanel.v1.ImageNRGBA
+	61, // 17: hyprpanel.v1.ControlServiceStatusResponse.log_level:type_name -> hyprpanel.config.v1.LogLevel
+	60, // 18: hyprpanel.v1.ControlServiceStatusResponse.panels:type_name -> hyprpanel.v1.ControlServiceStatusResponse.Panel
+	65, // 19: hyprpanel.v1.ControlServiceNotifyRequest.event:type_name -> hyprpanel.event.v1.Event
+	68, // 20: hyprpanel.v1.ControlServiceStatusResponse.Panel.edge:type_name -> hyprpanel.config.v1.Edge
+	5,  // 21: hyprpanel.v1.PanelService.Init:input_type -> hyprpanel.v1.PanelServiceInitRequest
+	7,  // 22: hyprpanel.v1.PanelService.Events:input_type -> hyprpanel.v1.PanelServiceEventsRequest
+	11, // 23: hyprpanel.v1.PanelService.SetVisible:input_type -> hyprpanel.v1.PanelServiceSetVisibleRequest
+	15, // 24: hyprpanel.v1.PanelService.UpdateStyle:input_type -> hyprpanel.v1.PanelServiceUpdateStyleRequest
+	13, // 25: hyprpanel.v1.PanelService.SetLogLevel:input_type -> hyprpanel.v1.PanelServiceSetLogLevelRequest
+	17, // 26: hyprpanel.v1.PanelService.Close:input_type -> hyprpanel.v1.PanelServiceCloseRequest
+	19, // 27: hyprpanel.v1.HostService.Exec:input_type -> hyprpanel.v1.HostServiceExecRequest
+	21, // 28: hyprpanel.v1.HostService.FindApplication:input_type -> hyprpanel.v1.HostServiceFindApplicationRequest
+	23, // 29: hyprpanel.v1.HostService.SystrayActivate:input_type -> hyprpanel.v1.HostServiceSystrayActivateRequest
+	25, // 30: hyprpanel.v1.HostService.SystraySecondaryActivate:input_type -> hyprpanel.v1.HostServiceSystraySecondaryActivateRequest
+	27, // 31: hyprpanel.v1.HostService.SystrayScroll:input_type -> hyprpanel.v1.HostServiceSystrayScrollRequest
+	29, // 32: hyprpanel.v1.HostService.SystrayMenuContextActivate:input_type -> hyprpanel.v1.HostServiceSystrayMenuContextActivateRequest
+	31, // 33: hyprpanel.v1.HostService.SystrayMenuAboutToShow:input_type -> hyprpanel.v1.HostServiceSystrayMenuAboutToShowRequest
+	33, // 34: hyprpanel.v1.HostService.SystrayMenuEvent:input_type -> hyprpanel.v1.HostServiceSystrayMenuEventRequest
+	35, // 35: hyprpanel.v1.HostService.NotificationClosed:input_type -> hyprpanel.v1.HostServiceNotificationClosedRequest
+	37, // 36: hyprpanel.v1.HostService.NotificationAction:input_type -> hyprpanel.v1.HostServiceNotificationActionRequest
+	39, // 37: hyprpanel.v1.HostService.AudioSinkVolumeAdjust:input_type -> hyprpanel.v1.HostServiceAudioSinkVolumeAdjustRequest
+	41, // 38: hyprpanel.v1.HostService.AudioSinkMuteToggle:input_type -> hyprpanel.v1.HostServiceAudioSinkMuteToggleRequest
+	43, // 39: hyprpanel.v1.HostService.AudioSourceVolumeAdjust:input_type -> hyprpanel.v1.HostServiceAudioSourceVolumeAdjustRequest
+	45, // 40: hyprpanel.v1.HostService.AudioSourceMuteToggle:input_type -> hyprpanel.v1.HostServiceAudioSourceMuteToggleRequest
+	47, // 41: hyprpanel.v1.HostService.BrightnessAdjust:input_type -> hyprpanel.v1.HostServiceBrightnessAdjustRequest
+	49, // 42: hyprpanel.v1.HostService.CaptureFrame:input_type -> hyprpanel.v1.HostServiceCaptureFrameRequest
+	51, // 43: hyprpanel.v1.ControlService.Status:input_type -> hyprpanel.v1.ControlServiceStatusRequest
+	53, // 44: hyprpanel.v1.ControlService.Notify:input_type -> hyprpanel.v1.ControlServiceNotifyRequest
+	55, // 45: hyprpanel.v1.ControlService.PanelSetVisible:input_type -> hyprpanel.v1.ControlServicePanelSetVisibleRequest
+	57, // 46: hyprpanel.v1.ControlService.Reload:input_type -> hyprpanel.v1.ControlServiceReloadRequest
+	6,  // 47: hyprpanel.v1.PanelService.Init:output_type -> hyprpanel.v1.PanelServiceInitResponse
+	8,  // 48: hyprpanel.v1.PanelService.Events:output_type -> hyprpanel.v1.PanelServiceEventsResponse
+	12, // 49: hyprpanel.v1.PanelService.SetVisible:output_type -> hyprpanel.v1.PanelServiceSetVisibleResponse
+	16, // 50: hyprpanel.v1.PanelService.UpdateStyle:output_type -> hyprpanel.v1.PanelServiceUpdateStyleResponse
+	14, // 51: hyprpanel.v1.PanelService.SetLogLevel:output_type -> hyprpanel.v1.PanelServiceSetLogLevelResponse
+	18, // 52: hyprpanel.v1.PanelService.Close:output_type -> hyprpanel.v1.PanelServiceCloseResponse
+	20, // 53: hyprpanel.v1.HostService.Exec:output_type -> hyprpanel.v1.HostServiceExecResponse
+	22, // 54: hyprpanel.v1.HostService.FindApplication:output_type -> hyprpanel.v1.HostServiceFindApplicationResponse
+	24, // 55: hyprpanel.v1.HostService.SystrayActivate:output_type -> hyprpanel.v1.HostServiceSystrayActivateResponse
+	26, // 56: hyprpanel.v1.HostService.SystraySecondaryActivate:output_type -> hyprpanel.v1.HostServiceSystraySecondaryActivateResponse
+	28, // 57: hyprpanel.v1.HostService.SystrayScroll:output_type -> hyprpanel.v1.HostServiceSystrayScrollResponse
+	30, // 58: hyprpanel.v1.HostService.SystrayMenuContextActivate:output_type -> hyprpanel.v1.HostServiceSystrayMenuContextActivateResponse
+	32, // 59: hyprpanel.v1.HostService.SystrayMenuAboutToShow:output_type -> hyprpanel.v1.HostServiceSystrayMenuAboutToShowResponse
+	34, // 60: hyprpanel.v1.HostService.SystrayMenuEvent:output_type -> hyprpanel.v1.HostServiceSystrayMenuEventResponse
+	36, // 61: hyprpanel.v1.HostService.NotificationClosed:output_type -> hyprpanel.v1.HostServiceNotificationClosedResponse
+	38, // 62: hyprpanel.v1.HostService.NotificationAction:output_type -> hyprpanel.v1.HostServiceNotificationActionResponse
+	40, // 63: hyprpanel.v1.HostService.AudioSinkVolumeAdjust:output_type -> hyprpanel.v1.HostServiceAudioSinkVolumeAdjustResponse
+	42, // 64: hyprpanel.v1.HostService.AudioSinkMuteToggle:output_type -> hyprpanel.v1.HostServiceAudioSinkMuteToggleResponse
+	44, // 65: hyprpanel.v1.HostService.AudioSourceVolumeAdjust:output_type -> hyprpanel.v1.HostServiceAudioSourceVolumeAdjustResponse
+	46, // 66: hyprpanel.v1.HostService.AudioSourceMuteToggle:output_type -> hyprpanel.v1.HostServiceAudioSourceMuteToggleResponse
+	48, // 67: hyprpanel.v1.HostService.BrightnessAdjust:output_type -> hyprpanel.v1.HostServiceBrightnessAdjustResponse
+	50, // 68: hyprpanel.v1.HostService.CaptureFrame:output_type -> hyprpanel.v1.HostServiceCaptureFrameResponse
+	52, // 69: hyprpanel.v1.ControlService.Status:output_type -> hyprpanel.v1.ControlServiceStatusResponse
+	54, // 70: hyprpanel.v1.ControlService.Notify:output_type -> hyprpanel.v1.ControlServiceNotifyResponse
+	56, // 71: hyprpanel.v1.ControlService.PanelSetVisible:output_type -> hyprpanel.v1.ControlServicePanelSetVisibleResponse
+	58, // 72: hyprpanel.v1.ControlService.Reload:output_type -> hyprpanel.v1.ControlServiceReloadResponse
+	47, // [47:73] is the sub-list for method output_type
+	21, // [21:47] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_hyprpanel_v1_hyprpanel_proto_init() }
@@ -2934,7 +3915,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanelServiceNotifyRequest); i {
+			switch v := v.(*PanelServiceEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2946,7 +3927,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanelServiceNotifyResponse); i {
+			switch v := v.(*PanelServiceEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2982,7 +3963,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanelServiceCloseRequest); i {
+			switch v := v.(*PanelServiceSetVisibleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2994,7 +3975,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanelServiceCloseResponse); i {
+			switch v := v.(*PanelServiceSetVisibleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3006,7 +3987,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceExecRequest); i {
+			switch v := v.(*PanelServiceSetLogLevelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3018,7 +3999,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceExecResponse); i {
+			switch v := v.(*PanelServiceSetLogLevelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3030,7 +4011,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceFindApplicationRequest); i {
+			switch v := v.(*PanelServiceUpdateStyleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3042,7 +4023,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceFindApplicationResponse); i {
+			switch v := v.(*PanelServiceUpdateStyleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3054,7 +4035,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayActivateRequest); i {
+			switch v := v.(*PanelServiceCloseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3066,7 +4047,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayActivateResponse); i {
+			switch v := v.(*PanelServiceCloseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3078,7 +4059,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystraySecondaryActivateRequest); i {
+			switch v := v.(*HostServiceExecRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3090,7 +4071,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystraySecondaryActivateResponse); i {
+			switch v := v.(*HostServiceExecResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3102,7 +4083,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayScrollRequest); i {
+			switch v := v.(*HostServiceFindApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3114,7 +4095,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayScrollResponse); i {
+			switch v := v.(*HostServiceFindApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3126,7 +4107,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayMenuContextActivateRequest); i {
+			switch v := v.(*HostServiceSystrayActivateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3138,7 +4119,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayMenuContextActivateResponse); i {
+			switch v := v.(*HostServiceSystrayActivateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3150,7 +4131,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayMenuAboutToShowRequest); i {
+			switch v := v.(*HostServiceSystraySecondaryActivateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3162,7 +4143,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayMenuAboutToShowResponse); i {
+			switch v := v.(*HostServiceSystraySecondaryActivateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3174,7 +4155,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayMenuEventRequest); i {
+			switch v := v.(*HostServiceSystrayScrollRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3186,7 +4167,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayMenuEventResponse); i {
+			switch v := v.(*HostServiceSystrayScrollResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3198,7 +4179,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceNotificationClosedRequest); i {
+			switch v := v.(*HostServiceSystrayMenuContextActivateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3210,7 +4191,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceNotificationClosedResponse); i {
+			switch v := v.(*HostServiceSystrayMenuContextActivateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3222,7 +4203,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceNotificationActionRequest); i {
+			switch v := v.(*HostServiceSystrayMenuAboutToShowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3234,7 +4215,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceNotificationActionResponse); i {
+			switch v := v.(*HostServiceSystrayMenuAboutToShowResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3246,7 +4227,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSinkVolumeAdjustRequest); i {
+			switch v := v.(*HostServiceSystrayMenuEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3258,7 +4239,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSinkVolumeAdjustResponse); i {
+			switch v := v.(*HostServiceSystrayMenuEventResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3270,7 +4251,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSinkMuteToggleRequest); i {
+			switch v := v.(*HostServiceNotificationClosedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3282,7 +4263,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSinkMuteToggleResponse); i {
+			switch v := v.(*HostServiceNotificationClosedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3294,7 +4275,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSourceVolumeAdjustRequest); i {
+			switch v := v.(*HostServiceNotificationActionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3306,7 +4287,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSourceVolumeAdjustResponse); i {
+			switch v := v.(*HostServiceNotificationActionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3318,7 +4299,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSourceMuteToggleRequest); i {
+			switch v := v.(*HostServiceAudioSinkVolumeAdjustRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3330,7 +4311,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSourceMuteToggleResponse); i {
+			switch v := v.(*HostServiceAudioSinkVolumeAdjustResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3342,7 +4323,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceBrightnessAdjustRequest); i {
+			switch v := v.(*HostServiceAudioSinkMuteToggleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3354,7 +4335,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceBrightnessAdjustResponse); i {
+			switch v := v.(*HostServiceAudioSinkMuteToggleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3366,7 +4347,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceCaptureFrameRequest); i {
+			switch v := v.(*HostServiceAudioSourceVolumeAdjustRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3378,7 +4359,7 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceCaptureFrameResponse); i {
+			switch v := v.(*HostServiceAudioSourceVolumeAdjustResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3390,6 +4371,174 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			}
 		}
 		file_hyprpanel_v1_hyprpanel_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceAudioSourceMuteToggleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceAudioSourceMuteToggleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceBrightnessAdjustRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceBrightnessAdjustResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceCaptureFrameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceCaptureFrameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceNotifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceNotifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServicePanelSetVisibleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServicePanelSetVisibleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceReloadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceReloadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AppInfo_Action); i {
 			case 0:
 				return &v.state
@@ -3401,6 +4550,18 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceStatusResponse_Panel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3408,9 +4569,9 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hyprpanel_v1_hyprpanel_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   43,
+			NumMessages:   58,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_hyprpanel_v1_hyprpanel_proto_goTypes,
 		DependencyIndexes: file_hyprpanel_v1_hyprpanel_proto_depIdxs,
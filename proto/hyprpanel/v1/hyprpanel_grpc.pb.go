@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PanelService_Init_FullMethodName   = "/hyprpanel.v1.PanelService/Init"
-	PanelService_Notify_FullMethodName = "/hyprpanel.v1.PanelService/Notify"
-	PanelService_Close_FullMethodName  = "/hyprpanel.v1.PanelService/Close"
+	PanelService_Init_FullMethodName        = "/hyprpanel.v1.PanelService/Init"
+	PanelService_Events_FullMethodName      = "/hyprpanel.v1.PanelService/Events"
+	PanelService_SetVisible_FullMethodName  = "/hyprpanel.v1.PanelService/SetVisible"
+	PanelService_UpdateStyle_FullMethodName = "/hyprpanel.v1.PanelService/UpdateStyle"
+	PanelService_SetLogLevel_FullMethodName = "/hyprpanel.v1.PanelService/SetLogLevel"
+	PanelService_Close_FullMethodName       = "/hyprpanel.v1.PanelService/Close"
 )
 
 // PanelServiceClient is the client API for PanelService service.
@@ -29,7 +32,10 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PanelServiceClient interface {
 	Init(ctx context.Context, in *PanelServiceInitRequest, opts ...grpc.CallOption) (*PanelServiceInitResponse, error)
-	Notify(ctx context.Context, in *PanelServiceNotifyRequest, opts ...grpc.CallOption) (*PanelServiceNotifyResponse, error)
+	Events(ctx context.Context, opts ...grpc.CallOption) (PanelService_EventsClient, error)
+	SetVisible(ctx context.Context, in *PanelServiceSetVisibleRequest, opts ...grpc.CallOption) (*PanelServiceSetVisibleResponse, error)
+	UpdateStyle(ctx context.Context, in *PanelServiceUpdateStyleRequest, opts ...grpc.CallOption) (*PanelServiceUpdateStyleResponse, error)
+	SetLogLevel(ctx context.Context, in *PanelServiceSetLogLevelRequest, opts ...grpc.CallOption) (*PanelServiceSetLogLevelResponse, error)
 	Close(ctx context.Context, in *PanelServiceCloseRequest, opts ...grpc.CallOption) (*PanelServiceCloseResponse, error)
 }
 
@@ -50,9 +56,58 @@ func (c *panelServiceClient) Init(ctx context.Context, in *PanelServiceInitReque
 	return out, nil
 }
 
-func (c *panelServiceClient) Notify(ctx context.Context, in *PanelServiceNotifyRequest, opts ...grpc.CallOption) (*PanelServiceNotifyResponse, error) {
-	out := new(PanelServiceNotifyResponse)
-	err := c.cc.Invoke(ctx, PanelService_Notify_FullMethodName, in, out, opts...)
+func (c *panelServiceClient) Events(ctx context.Context, opts ...grpc.CallOption) (PanelService_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PanelService_ServiceDesc.Streams[0], PanelService_Events_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &panelServiceEventsClient{stream}
+	return x, nil
+}
+
+type PanelService_EventsClient interface {
+	Send(*PanelServiceEventsRequest) error
+	Recv() (*PanelServiceEventsResponse, error)
+	grpc.ClientStream
+}
+
+type panelServiceEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *panelServiceEventsClient) Send(m *PanelServiceEventsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *panelServiceEventsClient) Recv() (*PanelServiceEventsResponse, error) {
+	m := new(PanelServiceEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *panelServiceClient) SetVisible(ctx context.Context, in *PanelServiceSetVisibleRequest, opts ...grpc.CallOption) (*PanelServiceSetVisibleResponse, error) {
+	out := new(PanelServiceSetVisibleResponse)
+	err := c.cc.Invoke(ctx, PanelService_SetVisible_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) UpdateStyle(ctx context.Context, in *PanelServiceUpdateStyleRequest, opts ...grpc.CallOption) (*PanelServiceUpdateStyleResponse, error) {
+	out := new(PanelServiceUpdateStyleResponse)
+	err := c.cc.Invoke(ctx, PanelService_UpdateStyle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panelServiceClient) SetLogLevel(ctx context.Context, in *PanelServiceSetLogLevelRequest, opts ...grpc.CallOption) (*PanelServiceSetLogLevelResponse, error) {
+	out := new(PanelServiceSetLogLevelResponse)
+	err := c.cc.Invoke(ctx, PanelService_SetLogLevel_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +128,10 @@ func (c *panelServiceClient) Close(ctx context.Context, in *PanelServiceCloseReq
 // for forward compatibility
 type PanelServiceServer interface {
 	Init(context.Context, *PanelServiceInitRequest) (*PanelServiceInitResponse, error)
-	Notify(context.Context, *PanelServiceNotifyRequest) (*PanelServiceNotifyResponse, error)
+	Events(PanelService_EventsServer) error
+	SetVisible(context.Context, *PanelServiceSetVisibleRequest) (*PanelServiceSetVisibleResponse, error)
+	UpdateStyle(context.Context, *PanelServiceUpdateStyleRequest) (*PanelServiceUpdateStyleResponse, error)
+	SetLogLevel(context.Context, *PanelServiceSetLogLevelRequest) (*PanelServiceSetLogLevelResponse, error)
 	Close(context.Context, *PanelServiceCloseRequest) (*PanelServiceCloseResponse, error)
 	mustEmbedUnimplementedPanelServiceServer()
 }
@@ -85,8 +143,17 @@ type UnimplementedPanelServiceServer struct {
 func (UnimplementedPanelServiceServer) Init(context.Context, *PanelServiceInitRequest) (*PanelServiceInitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
 }
-func (UnimplementedPanelServiceServer) Notify(context.Context, *PanelServiceNotifyRequest) (*PanelServiceNotifyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Notify not implemented")
+func (UnimplementedPanelServiceServer) Events(PanelService_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (UnimplementedPanelServiceServer) SetVisible(context.Context, *PanelServiceSetVisibleRequest) (*PanelServiceSetVisibleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVisible not implemented")
+}
+func (UnimplementedPanelServiceServer) UpdateStyle(context.Context, *PanelServiceUpdateStyleRequest) (*PanelServiceUpdateStyleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStyle not implemented")
+}
+func (UnimplementedPanelServiceServer) SetLogLevel(context.Context, *PanelServiceSetLogLevelRequest) (*PanelServiceSetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
 }
 func (UnimplementedPanelServiceServer) Close(context.Context, *PanelServiceCloseRequest) (*PanelServiceCloseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
@@ -122,20 +189,82 @@ func _PanelService_Init_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PanelService_Notify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PanelServiceNotifyRequest)
+func _PanelService_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PanelServiceServer).Events(&panelServiceEventsServer{stream})
+}
+
+type PanelService_EventsServer interface {
+	Send(*PanelServiceEventsResponse) error
+	Recv() (*PanelServiceEventsRequest, error)
+	grpc.ServerStream
+}
+
+type panelServiceEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *panelServiceEventsServer) Send(m *PanelServiceEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *panelServiceEventsServer) Recv() (*PanelServiceEventsRequest, error) {
+	m := new(PanelServiceEventsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _PanelService_SetVisible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PanelServiceSetVisibleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).SetVisible(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_SetVisible_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).SetVisible(ctx, req.(*PanelServiceSetVisibleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_UpdateStyle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PanelServiceUpdateStyleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PanelServiceServer).Notify(ctx, in)
+		return srv.(PanelServiceServer).UpdateStyle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PanelService_Notify_FullMethodName,
+		FullMethod: PanelService_UpdateStyle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PanelServiceServer).Notify(ctx, req.(*PanelServiceNotifyRequest))
+		return srv.(PanelServiceServer).UpdateStyle(ctx, req.(*PanelServiceUpdateStyleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PanelService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PanelServiceSetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).SetLogLevel(ctx, req.(*PanelServiceSetLogLevelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -170,15 +299,30 @@ var PanelService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _PanelService_Init_Handler,
 		},
 		{
-			MethodName: "Notify",
-			Handler:    _PanelService_Notify_Handler,
+			MethodName: "SetVisible",
+			Handler:    _PanelService_SetVisible_Handler,
+		},
+		{
+			MethodName: "UpdateStyle",
+			Handler:    _PanelService_UpdateStyle_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _PanelService_SetLogLevel_Handler,
 		},
 		{
 			MethodName: "Close",
 			Handler:    _PanelService_Close_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _PanelService_Events_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "hyprpanel/v1/hyprpanel.proto",
 }
 
@@ -826,3 +970,204 @@ var HostService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hyprpanel/v1/hyprpanel.proto",
 }
+
+const (
+	ControlService_Status_FullMethodName          = "/hyprpanel.v1.ControlService/Status"
+	ControlService_Notify_FullMethodName          = "/hyprpanel.v1.ControlService/Notify"
+	ControlService_PanelSetVisible_FullMethodName = "/hyprpanel.v1.ControlService/PanelSetVisible"
+	ControlService_Reload_FullMethodName          = "/hyprpanel.v1.ControlService/Reload"
+)
+
+// ControlServiceClient is the client API for ControlService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ControlServiceClient interface {
+	Status(ctx context.Context, in *ControlServiceStatusRequest, opts ...grpc.CallOption) (*ControlServiceStatusResponse, error)
+	Notify(ctx context.Context, in *ControlServiceNotifyRequest, opts ...grpc.CallOption) (*ControlServiceNotifyResponse, error)
+	PanelSetVisible(ctx context.Context, in *ControlServicePanelSetVisibleRequest, opts ...grpc.CallOption) (*ControlServicePanelSetVisibleResponse, error)
+	Reload(ctx context.Context, in *ControlServiceReloadRequest, opts ...grpc.CallOption) (*ControlServiceReloadResponse, error)
+}
+
+type controlServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewControlServiceClient(cc grpc.ClientConnInterface) ControlServiceClient {
+	return &controlServiceClient{cc}
+}
+
+func (c *controlServiceClient) Status(ctx context.Context, in *ControlServiceStatusRequest, opts ...grpc.CallOption) (*ControlServiceStatusResponse, error) {
+	out := new(ControlServiceStatusResponse)
+	err := c.cc.Invoke(ctx, ControlService_Status_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) Notify(ctx context.Context, in *ControlServiceNotifyRequest, opts ...grpc.CallOption) (*ControlServiceNotifyResponse, error) {
+	out := new(ControlServiceNotifyResponse)
+	err := c.cc.Invoke(ctx, ControlService_Notify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) PanelSetVisible(ctx context.Context, in *ControlServicePanelSetVisibleRequest, opts ...grpc.CallOption) (*ControlServicePanelSetVisibleResponse, error) {
+	out := new(ControlServicePanelSetVisibleResponse)
+	err := c.cc.Invoke(ctx, ControlService_PanelSetVisible_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) Reload(ctx context.Context, in *ControlServiceReloadRequest, opts ...grpc.CallOption) (*ControlServiceReloadResponse, error) {
+	out := new(ControlServiceReloadResponse)
+	err := c.cc.Invoke(ctx, ControlService_Reload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ControlServiceServer is the server API for ControlService service.
+// All implementations must embed UnimplementedControlServiceServer
+// for forward compatibility
+type ControlServiceServer interface {
+	Status(context.Context, *ControlServiceStatusRequest) (*ControlServiceStatusResponse, error)
+	Notify(context.Context, *ControlServiceNotifyRequest) (*ControlServiceNotifyResponse, error)
+	PanelSetVisible(context.Context, *ControlServicePanelSetVisibleRequest) (*ControlServicePanelSetVisibleResponse, error)
+	Reload(context.Context, *ControlServiceReloadRequest) (*ControlServiceReloadResponse, error)
+	mustEmbedUnimplementedControlServiceServer()
+}
+
+// UnimplementedControlServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedControlServiceServer struct {
+}
+
+func (UnimplementedControlServiceServer) Status(context.Context, *ControlServiceStatusRequest) (*ControlServiceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedControlServiceServer) Notify(context.Context, *ControlServiceNotifyRequest) (*ControlServiceNotifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Notify not implemented")
+}
+func (UnimplementedControlServiceServer) PanelSetVisible(context.Context, *ControlServicePanelSetVisibleRequest) (*ControlServicePanelSetVisibleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PanelSetVisible not implemented")
+}
+func (UnimplementedControlServiceServer) Reload(context.Context, *ControlServiceReloadRequest) (*ControlServiceReloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reload not implemented")
+}
+func (UnimplementedControlServiceServer) mustEmbedUnimplementedControlServiceServer() {}
+
+// UnsafeControlServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ControlServiceServer will
+// result in compilation errors.
+type UnsafeControlServiceServer interface {
+	mustEmbedUnimplementedControlServiceServer()
+}
+
+func RegisterControlServiceServer(s grpc.ServiceRegistrar, srv ControlServiceServer) {
+	s.RegisterService(&ControlService_ServiceDesc, srv)
+}
+
+func _ControlService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlServiceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_Status_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).Status(ctx, req.(*ControlServiceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_Notify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlServiceNotifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).Notify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_Notify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).Notify(ctx, req.(*ControlServiceNotifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_PanelSetVisible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlServicePanelSetVisibleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).PanelSetVisible(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_PanelSetVisible_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).PanelSetVisible(ctx, req.(*ControlServicePanelSetVisibleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_Reload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlServiceReloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).Reload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_Reload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).Reload(ctx, req.(*ControlServiceReloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ControlService_ServiceDesc is the grpc.ServiceDesc for ControlService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ControlService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hyprpanel.v1.ControlService",
+	HandlerType: (*ControlServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Status",
+			Handler:    _ControlService_Status_Handler,
+		},
+		{
+			MethodName: "Notify",
+			Handler:    _ControlService_Notify_Handler,
+		},
+		{
+			MethodName: "PanelSetVisible",
+			Handler:    _ControlService_PanelSetVisible_Handler,
+		},
+		{
+			MethodName: "Reload",
+			Handler:    _ControlService_Reload_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hyprpanel/v1/hyprpanel.proto",
+}
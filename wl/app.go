@@ -250,6 +250,12 @@ OUTER:
 	return img, nil
 }
 
+// ToplevelExport reports whether the compositor provides the toplevel export
+// protocol required by CaptureFrame.
+func (a *App) ToplevelExport() bool {
+	return a.tl != nil
+}
+
 func (a *App) Close() error {
 	if a.tl != nil {
 		if err := a.tl.Destroy(); err != nil {